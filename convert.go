@@ -63,6 +63,69 @@ func (sl Slice[T]) FlattenAll() Slice[U] {
 	}
 }
 
+// # FlattenDedup
+//
+// Removes "all" layers of nested structure and all duplicate values in a single pass.
+// Values are kept in order of first occurance.
+//
+//	[[1,2],[2,[3,1]],[4]]FlattenDedup() return [1,2,3,4]
+//
+// # Caution!
+//
+// The values at the bottom of the nested structure are used as map keys, so they need to be comparable.
+// This holds for all the builtin types, but panics for custom types that are not comparable.
+func (sl Slice[T]) FlattenDedup() Slice[U] {
+	var result Slice[U]
+	seen := make(map[any]struct{})
+	var visit func(v reflect.Value)
+	visit = func(v reflect.Value) {
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Slice {
+			for i := 0; i < v.Len(); i++ {
+				visit(v.Index(i))
+			}
+			return
+		}
+		value := v.Interface().(U)
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			result.Push(value)
+		}
+	}
+	visit(reflect.ValueOf(sl))
+	return result
+}
+
+// # FlattenWithPaths
+//
+// Removes "all" layers of nested structure, and return every value at the bottom together with the indices
+// that lead to it. Each entry has the indices of the path, in order, followed by the value.
+//
+//	[[1,2],[3]]FlattenWithPaths() return [[0,0,1],[0,1,2],[1,0,3]]
+func (sl Slice[T]) FlattenWithPaths() Slice[U] {
+	var result Slice[U]
+	var visit func(v reflect.Value, path Slice[U])
+	visit = func(v reflect.Value, path Slice[U]) {
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Slice {
+			for i := 0; i < v.Len(); i++ {
+				visit(v.Index(i), append(path[:len(path):len(path)], Int(i)))
+			}
+			return
+		}
+		entry := make(Slice[U], 0, len(path)+1)
+		entry.Push(path...)
+		entry.Push(v.Interface().(U))
+		result.Push(entry)
+	}
+	visit(reflect.ValueOf(sl), New[U]())
+	return result
+}
+
 func (sl Slice[T]) FlatMap(f func(v T) T) Slice[U] {
 	mapped := New[T]()
 	for _, v := range sl {
@@ -158,6 +221,29 @@ func (sl Slice[T]) SplitBy(f func(v T) bool) Slice[U] {
 	return sp
 }
 
+// # SplitAtIndices
+//
+//	Split the slice before each of the given indices.
+//	Indices that are out of range, not larger than the index before them, 0 or Len() are ignored,
+//	so none of the groups are empty unless the slice is.
+//
+//	[1,2,3,4,5]SplitAtIndices([2,4]) return [[1,2],[3,4],[5]]
+//	[1,2,3,4,5]SplitAtIndices([0,2,2,5]) return [[1,2],[3,4,5]]
+//	[1,2,3,4,5]SplitAtIndices([]) return [[1,2,3,4,5]]
+func (sl Slice[T]) SplitAtIndices(indices Slice[Int]) Slice[U] {
+	sp := make(Slice[U], 0, indices.Len()+1)
+	start := 0
+	for _, i := range indices {
+		if int(i) <= start || int(i) >= sl.Len() {
+			continue
+		}
+		sp.Push(sl[start:i:i])
+		start = int(i)
+	}
+	sp.Push(sl[start:])
+	return sp
+}
+
 // # Chunk
 //
 // Create a new slice of non-overlapping chunks with the given size.
@@ -198,6 +284,9 @@ func (sl Slice[T]) Chunk(size uint) Slice[U] {
 // # ChunkBy
 //
 // Create a new slice of non-overlapping chunks by the given function.
+// A new chunk is started whenever f returns false for the previous and the current element.
+//
+//	[1,3,2,4,5]ChunkBy(func(a, b T) bool {return a%2 == b%2}) return [[1,3],[2,4],[5]]
 func (sl Slice[T]) ChunkBy(f func(T, T) bool) Slice[U] {
 	if sl.IsEmpty() {
 		return New[U]()
@@ -222,6 +311,116 @@ func (sl Slice[T]) ChunkBy(f func(T, T) bool) Slice[U] {
 	return chunks
 }
 
+// # ChunkWeighted
+//
+// Create a new slice of non-overlapping chunks where the total weight of each chunk does not exceed maxWeight.
+// A new chunk is started whenever adding the next element would exceed maxWeight.
+// An element that weighs more than maxWeight on its own is put in a chunk of its own.
+//
+//	[2,3,1,4]ChunkWeighted(5, func(v T) F64 {return v}) return [[2,3],[1,4]]
+func (sl Slice[T]) ChunkWeighted(maxWeight F64, weightFn func(T) F64) Slice[U] {
+	chunks := New[U]()
+	if sl.IsEmpty() {
+		return chunks
+	}
+
+	var chunk Slice[T]
+	var weight F64
+	for _, v := range sl {
+		w := weightFn(v)
+		if !chunk.IsEmpty() && weight+w > maxWeight {
+			chunks.Push(chunk)
+			chunk.Clear()
+			weight = 0
+		}
+		chunk.Push(v)
+		weight += w
+	}
+	chunks.Push(chunk)
+
+	return chunks
+}
+
+// # Batches
+//
+// Divide the slice into the given amount of contiguous batches, with sizes as equal as possible.
+// The batches are views into the slice, not copies. If there are more workers than elements,
+// every element gets a batch of its own.
+//
+//	[1,2,3,4,5]Batches(2) return [[1,2,3],[4,5]]
+//	[1,2]Batches(3) return [[1],[2]]
+//
+// # Caution!
+//
+// Panics if workers is less than 1
+func (sl Slice[T]) Batches(workers int) Slice[U] {
+	if workers < 1 {
+		panic("amount of workers cannot be less than 1")
+	}
+	bounds := batchBounds(sl.Len(), workers)
+	batches := make(Slice[U], 0, len(bounds)-1)
+	for i := 1; i < len(bounds); i++ {
+		batches.Push(sl[bounds[i-1]:bounds[i]:bounds[i]])
+	}
+	return batches
+}
+
+// Returns the bounds of n indexes split into min(workers, n) contiguous ranges, with sizes as equal as possible.
+// Range i is bounds[i]:bounds[i+1], and the first ranges are one longer when n is not divisible by workers.
+func batchBounds(n, workers int) []int {
+	workers = min(workers, n)
+	bounds := make([]int, workers+1)
+	size, rest := 0, 0
+	if workers > 0 {
+		size, rest = n/workers, n%workers
+	}
+	for i := 0; i < workers; i++ {
+		bounds[i+1] = bounds[i] + size
+		if i < rest {
+			bounds[i+1]++
+		}
+	}
+	return bounds
+}
+
+// # SplitOnChange
+//
+// Create a new slice of groups, starting a new group whenever the key returned by keyFn differs from the key of the previous element.
+//
+//	[1,3,2,4,5]SplitOnChange(func(v T) V {return v%2}) return [[1,3],[2,4],[5]]
+func (sl Slice[T]) SplitOnChange(keyFn func(T) V) Slice[U] {
+	groups := New[U]()
+	if sl.IsEmpty() {
+		return groups
+	}
+
+	var group Slice[T]
+	prevKey := keyFn(sl[0])
+	for _, v := range sl {
+		key := keyFn(v)
+		if !key.Eq(prevKey) {
+			groups.Push(group)
+			group.Clear()
+		}
+		group.Push(v)
+		prevKey = key
+	}
+	groups.Push(group)
+
+	return groups
+}
+
+// # GroupBy
+//
+// Create a new slice of groups of consecutive elements that have the same key.
+// Unlike ChunkBy, which compares two neighbouring elements with a binary predicate, GroupBy compares
+// the keys of the elements with Eq. The result is always nested, even if there is only one group.
+//
+//	[1,1,2,3,3]GroupBy(func(v T) T {return v}) return [[1,1],[2],[3,3]]
+func (sl Slice[T]) GroupBy(key func(T) T) Slice[U] {
+	return sl.SplitOnChange(func(v T) V { return key(v) })
+}
+
 // # Windows
 //
 // Create overlapping windows of given size.
@@ -249,3 +448,92 @@ func (sl Slice[T]) Windows(size uint) Slice[U] {
 	}
 	return windows
 }
+
+// # EnumeratePairs
+//
+// Create a new slice of [index, value] pairs.
+//
+//	['a','b','c']EnumeratePairs() return [[0,'a'],[1,'b'],[2,'c']]
+func (sl Slice[T]) EnumeratePairs() Slice[U] {
+	pairs := make(Slice[U], 0, sl.Len())
+	for i, v := range sl {
+		pairs.Push(New[U](Int(i), v))
+	}
+	return pairs
+}
+
+// # Power
+//
+// Create a new slice of all sequences of length k with values drawn from the slice, with repetition.
+// The result has sl.Len()^k sequences. If k is 0, the result holds a single empty sequence.
+//
+//	[0,1]Power(2) return [[0,0],[0,1],[1,0],[1,1]]
+func (sl Slice[T]) Power(k uint) Slice[U] {
+	sequences := []Slice[T]{New[T]()}
+	for ; k > 0; k-- {
+		next := make([]Slice[T], 0, len(sequences)*sl.Len())
+		for _, seq := range sequences {
+			for _, v := range sl {
+				extended := make(Slice[T], seq.Len(), seq.Len()+1)
+				copy(extended, seq)
+				next = append(next, append(extended, v))
+			}
+		}
+		sequences = next
+	}
+
+	power := make(Slice[U], 0, len(sequences))
+	for _, seq := range sequences {
+		power.Push(seq)
+	}
+	return power
+}
+
+// # OneHot
+//
+// Create a new slice with one row per element, where each row has a 1 for the category the element
+// is equal to and a 0 for every other category.
+//
+//	["b","a"]OneHot(["a","b","c"]) return [[0,1,0],[1,0,0]]
+//
+// Returns ErrDoesNotExist if an element is not equal to any of the categories.
+func (sl Slice[T]) OneHot(categories Slice[T]) (Slice[U], error) {
+	rows := make(Slice[U], 0, sl.Len())
+	for _, v := range sl {
+		i, err := categories.FirstIndexOf(v)
+		if err != nil {
+			return New[U](), err
+		}
+		row := make(Slice[Int], categories.Len())
+		row[i] = 1
+		rows.Push(row)
+	}
+	return rows, nil
+}
+
+// # SplitHeader
+//
+// Split a slice of Slice[Str] rows, such as parsed tabular data, into the first row and the rest of the rows.
+// The slice itself is not modified.
+//
+//	[["name","age"],["Ann","30"],["Bob","25"]]SplitHeader() return ["name","age"], [["Ann","30"],["Bob","25"]]
+//
+// Returns ErrIsEmpty if the slice is empty and ErrTypeMismatch if the rows are not of type Slice[Str].
+func (sl Slice[T]) SplitHeader() (header Slice[Str], rows Slice[U], err error) {
+	if sl.IsEmpty() {
+		return New[Str](), New[U](), ErrIsEmpty
+	}
+	rows = make(Slice[U], 0, sl.Len()-1)
+	for i, v := range sl {
+		row, ok := any(v).(Slice[Str])
+		if !ok {
+			return New[Str](), New[U](), ErrTypeMismatch
+		}
+		if i == 0 {
+			header = row
+		} else {
+			rows.Push(row)
+		}
+	}
+	return header, rows, nil
+}
@@ -0,0 +1,263 @@
+package sliceutils
+
+import "testing"
+
+func TestSplitOnChange(t *testing.T) {
+	parity := func(v Int) V { return v % 2 }
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[U]
+	}{
+		{Slice[Int]{1, 3, 2, 4, 5, 7, 6}, Slice[U]{Slice[Int]{1, 3}, Slice[Int]{2, 4}, Slice[Int]{5, 7}, Slice[Int]{6}}},
+		{Slice[Int]{2, 4}, Slice[U]{Slice[Int]{2, 4}}},
+		{Slice[Int]{}, Slice[U]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.SplitOnChange(parity); !got.Eq(tt.want) {
+			t.Errorf("%v.SplitOnChange(parity) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
+
+func TestEnumeratePairs(t *testing.T) {
+	got := Slice[Str]{"a", "b", "c"}.EnumeratePairs()
+	want := Slice[U]{Slice[U]{Int(0), Str("a")}, Slice[U]{Int(1), Str("b")}, Slice[U]{Int(2), Str("c")}}
+	if !got.Eq(want) {
+		t.Errorf("EnumeratePairs() = %v, want %v", got, want)
+	}
+	if got := (Slice[Str]{}).EnumeratePairs(); !got.IsEmpty() {
+		t.Errorf("EnumeratePairs() on empty slice = %v, want []", got)
+	}
+}
+
+func TestFlattenDedup(t *testing.T) {
+	sl := Slice[U]{
+		Slice[Int]{1, 2},
+		Slice[U]{Int(2), Slice[Int]{3, 1}},
+		Slice[Int]{4, 3},
+	}
+	want := Slice[U]{Int(1), Int(2), Int(3), Int(4)}
+	if got := sl.FlattenDedup(); !got.Eq(want) {
+		t.Errorf("%v.FlattenDedup() = %v, want %v", sl, got, want)
+	}
+
+	flat := Slice[Int]{3, 3, 1}
+	if got := flat.FlattenDedup(); !got.Eq(Slice[U]{Int(3), Int(1)}) {
+		t.Errorf("%v.FlattenDedup() = %v, want [3, 1]", flat, got)
+	}
+}
+
+func TestChunkWeighted(t *testing.T) {
+	weight := func(v Int) F64 { return F64(v) }
+	tests := []struct {
+		sl        Slice[Int]
+		maxWeight F64
+		want      Slice[U]
+	}{
+		{Slice[Int]{2, 3, 1, 4}, 5, Slice[U]{Slice[Int]{2, 3}, Slice[Int]{1, 4}}},
+		{Slice[Int]{1, 1, 1, 1, 1}, 2, Slice[U]{Slice[Int]{1, 1}, Slice[Int]{1, 1}, Slice[Int]{1}}},
+		{Slice[Int]{1, 9, 1}, 5, Slice[U]{Slice[Int]{1}, Slice[Int]{9}, Slice[Int]{1}}},
+		{Slice[Int]{5, 5}, 5, Slice[U]{Slice[Int]{5}, Slice[Int]{5}}},
+		{Slice[Int]{1, 2}, 10, Slice[U]{Slice[Int]{1, 2}}},
+		{Slice[Int]{}, 5, Slice[U]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.ChunkWeighted(tt.maxWeight, weight); !got.Eq(tt.want) {
+			t.Errorf("%v.ChunkWeighted(%v) = %v, want %v", tt.sl, tt.maxWeight, got, tt.want)
+		}
+	}
+}
+
+func TestBatches(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4, 5, 6}
+	tests := []struct {
+		workers int
+		want    Slice[U]
+	}{
+		{2, Slice[U]{Slice[Int]{1, 2, 3}, Slice[Int]{4, 5, 6}}},
+		{4, Slice[U]{Slice[Int]{1, 2}, Slice[Int]{3, 4}, Slice[Int]{5}, Slice[Int]{6}}},
+		{8, Slice[U]{Slice[Int]{1}, Slice[Int]{2}, Slice[Int]{3}, Slice[Int]{4}, Slice[Int]{5}, Slice[Int]{6}}},
+		{1, Slice[U]{Slice[Int]{1, 2, 3, 4, 5, 6}}},
+	}
+	for _, tt := range tests {
+		if got := sl.Batches(tt.workers); !got.Eq(tt.want) {
+			t.Errorf("Batches(%v) = %v, want %v", tt.workers, got, tt.want)
+		}
+	}
+
+	if got := (Slice[Int]{}).Batches(3); !got.IsEmpty() {
+		t.Errorf("Batches(3) on empty slice = %v, want []", got)
+	}
+}
+
+func TestSplitAtIndices(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4, 5}
+	tests := []struct {
+		indices Slice[Int]
+		want    Slice[U]
+	}{
+		{Slice[Int]{2, 4}, Slice[U]{Slice[Int]{1, 2}, Slice[Int]{3, 4}, Slice[Int]{5}}},
+		{Slice[Int]{0, 2, 2, 5}, Slice[U]{Slice[Int]{1, 2}, Slice[Int]{3, 4, 5}}},
+		{Slice[Int]{3, 1}, Slice[U]{Slice[Int]{1, 2, 3}, Slice[Int]{4, 5}}},
+		{Slice[Int]{-1, 9}, Slice[U]{Slice[Int]{1, 2, 3, 4, 5}}},
+		{Slice[Int]{}, Slice[U]{Slice[Int]{1, 2, 3, 4, 5}}},
+	}
+	for _, tt := range tests {
+		if got := sl.SplitAtIndices(tt.indices); !got.Eq(tt.want) {
+			t.Errorf("%v.SplitAtIndices(%v) = %v, want %v", sl, tt.indices, got, tt.want)
+		}
+	}
+
+	// Appending to a group does not overwrite the next one
+	groups := sl.SplitAtIndices(Slice[Int]{2})
+	first := groups[0].(Slice[Int])
+	first.Push(9)
+	if !sl.Eq(Slice[Int]{1, 2, 3, 4, 5}) {
+		t.Errorf("appending to the first group modified the slice to %v", sl)
+	}
+}
+
+func TestPower(t *testing.T) {
+	sl := Slice[Int]{0, 1}
+	tests := []struct {
+		k    uint
+		want Slice[U]
+	}{
+		{0, Slice[U]{Slice[Int]{}}},
+		{1, Slice[U]{Slice[Int]{0}, Slice[Int]{1}}},
+		{2, Slice[U]{Slice[Int]{0, 0}, Slice[Int]{0, 1}, Slice[Int]{1, 0}, Slice[Int]{1, 1}}},
+		{3, Slice[U]{
+			Slice[Int]{0, 0, 0}, Slice[Int]{0, 0, 1}, Slice[Int]{0, 1, 0}, Slice[Int]{0, 1, 1},
+			Slice[Int]{1, 0, 0}, Slice[Int]{1, 0, 1}, Slice[Int]{1, 1, 0}, Slice[Int]{1, 1, 1},
+		}},
+	}
+	for _, tt := range tests {
+		if got := sl.Power(tt.k); !got.Eq(tt.want) {
+			t.Errorf("%v.Power(%v) = %v, want %v", sl, tt.k, got, tt.want)
+		}
+	}
+
+	if got := (Slice[Int]{1, 2, 3}).Power(3); got.Len() != 27 {
+		t.Errorf("[1, 2, 3].Power(3) has %v sequences, want 27", got.Len())
+	}
+	if got := (Slice[Int]{}).Power(2); !got.IsEmpty() {
+		t.Errorf("[].Power(2) = %v, want []", got)
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	sameParity := func(a, b Int) bool { return a%2 == b%2 }
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[U]
+	}{
+		{Slice[Int]{1, 3, 2, 4, 5}, Slice[U]{Slice[Int]{1, 3}, Slice[Int]{2, 4}, Slice[Int]{5}}},
+		{Slice[Int]{1, 2, 3}, Slice[U]{Slice[Int]{1}, Slice[Int]{2}, Slice[Int]{3}}},
+		{Slice[Int]{}, Slice[U]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.ChunkBy(sameParity); !got.Eq(tt.want) {
+			t.Errorf("%v.ChunkBy(sameParity) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	// A single chunk is flattened
+	odd := Slice[Int]{1, 3, 5}
+	if got := odd.ChunkBy(sameParity); !got.Eq(Slice[U]{Int(1), Int(3), Int(5)}) {
+		t.Errorf("%v.ChunkBy(sameParity) = %v, want [1, 3, 5]", odd, got)
+	}
+}
+
+func TestOneHot(t *testing.T) {
+	categories := Slice[Str]{"a", "b", "c"}
+	sl := Slice[Str]{"b", "a", "c", "b"}
+	want := Slice[U]{Slice[Int]{0, 1, 0}, Slice[Int]{1, 0, 0}, Slice[Int]{0, 0, 1}, Slice[Int]{0, 1, 0}}
+	if got, err := sl.OneHot(categories); err != nil || !got.Eq(want) {
+		t.Errorf("%v.OneHot(%v) = %v, %v, want %v", sl, categories, got, err, want)
+	}
+
+	if got, err := (Slice[Str]{}).OneHot(categories); err != nil || !got.IsEmpty() {
+		t.Errorf("[].OneHot(%v) = %v, %v, want []", categories, got, err)
+	}
+	if _, err := (Slice[Str]{"a", "d"}).OneHot(categories); err != ErrDoesNotExist {
+		t.Errorf("OneHot() with an unknown category returned %v, want %v", err, ErrDoesNotExist)
+	}
+}
+
+func TestFlattenWithPaths(t *testing.T) {
+	tests := []struct {
+		sl   Slice[U]
+		want Slice[U]
+	}{
+		{
+			Slice[U]{Slice[Int]{1, 2}, Slice[Int]{3}},
+			Slice[U]{Slice[U]{Int(0), Int(0), Int(1)}, Slice[U]{Int(0), Int(1), Int(2)}, Slice[U]{Int(1), Int(0), Int(3)}},
+		},
+		{
+			Slice[U]{Str("a"), Slice[U]{Str("b"), Slice[Str]{"c"}}},
+			Slice[U]{Slice[U]{Int(0), Str("a")}, Slice[U]{Int(1), Int(0), Str("b")}, Slice[U]{Int(1), Int(1), Int(0), Str("c")}},
+		},
+		{Slice[U]{Slice[Int]{}, Slice[Int]{4}}, Slice[U]{Slice[U]{Int(1), Int(0), Int(4)}}},
+		{Slice[U]{}, Slice[U]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.FlattenWithPaths(); !got.Eq(tt.want) {
+			t.Errorf("%v.FlattenWithPaths() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	flat := Slice[Str]{"x", "y"}
+	want := Slice[U]{Slice[U]{Int(0), Str("x")}, Slice[U]{Int(1), Str("y")}}
+	if got := flat.FlattenWithPaths(); !got.Eq(want) {
+		t.Errorf("%v.FlattenWithPaths() = %v, want %v", flat, got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	identity := func(v Int) Int { return v }
+	parity := func(v Int) Int { return v % 2 }
+	tests := []struct {
+		sl   Slice[Int]
+		key  func(Int) Int
+		want Slice[U]
+	}{
+		{Slice[Int]{1, 1, 2, 3, 3}, identity, Slice[U]{Slice[Int]{1, 1}, Slice[Int]{2}, Slice[Int]{3, 3}}},
+		{Slice[Int]{1, 3, 2, 4, 5}, parity, Slice[U]{Slice[Int]{1, 3}, Slice[Int]{2, 4}, Slice[Int]{5}}},
+		// Only consecutive elements are grouped
+		{Slice[Int]{1, 2, 1}, identity, Slice[U]{Slice[Int]{1}, Slice[Int]{2}, Slice[Int]{1}}},
+		{Slice[Int]{7, 7}, identity, Slice[U]{Slice[Int]{7, 7}}},
+		{Slice[Int]{}, identity, Slice[U]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.GroupBy(tt.key); !got.Eq(tt.want) {
+			t.Errorf("%v.GroupBy() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
+
+func TestSplitHeader(t *testing.T) {
+	table := Slice[Slice[Str]]{{"name", "age"}, {"Ann", "30"}, {"Bob", "25"}}
+	header, rows, err := table.SplitHeader()
+	if err != nil || !header.Eq(Slice[Str]{"name", "age"}) || !rows.Eq(Slice[U]{Slice[Str]{"Ann", "30"}, Slice[Str]{"Bob", "25"}}) {
+		t.Errorf("%v.SplitHeader() = %v, %v, %v", table, header, rows, err)
+	}
+	if table.Len() != 3 {
+		t.Errorf("SplitHeader() modified the slice to %v", table)
+	}
+
+	onlyHeader := Slice[Slice[Str]]{{"name"}}
+	if header, rows, err := onlyHeader.SplitHeader(); err != nil || !header.Eq(Slice[Str]{"name"}) || !rows.IsEmpty() {
+		t.Errorf("%v.SplitHeader() = %v, %v, %v, want [name], []", onlyHeader, header, rows, err)
+	}
+
+	if _, _, err := (Slice[Slice[Str]]{}).SplitHeader(); err != ErrIsEmpty {
+		t.Errorf("SplitHeader() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	mixed := Slice[U]{Slice[Str]{"name"}, Slice[Int]{1}}
+	if _, _, err := mixed.SplitHeader(); err != ErrTypeMismatch {
+		t.Errorf("%v.SplitHeader() returned %v, want %v", mixed, err, ErrTypeMismatch)
+	}
+	if _, _, err := (Slice[Str]{"a"}).SplitHeader(); err != ErrTypeMismatch {
+		t.Errorf("SplitHeader() on Slice[Str] returned %v, want %v", err, ErrTypeMismatch)
+	}
+}
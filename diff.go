@@ -0,0 +1,98 @@
+package sliceutils
+
+// # EditOp
+//
+// The kind of operation of an Edit.
+type EditOp int8
+
+const (
+	EditKeep   EditOp = iota // The value is in both slices
+	EditInsert               // The value is only in the other slice
+	EditDelete               // The value is only in the original slice
+)
+
+// # Edit
+//
+// A single operation in the difference between two slices, as returned by Diff.
+type Edit struct {
+	Op    EditOp
+	Value U
+}
+
+// Implementation of Eq on Edit
+func (e Edit) Eq(v any) bool {
+	switch v := v.(type) {
+	case Edit:
+		return e.Op == v.Op && e.Value.Eq(v.Value)
+	default:
+		return false
+	}
+}
+
+// Edits are ordered by Op first and Value second
+func (e Edit) Gt(v any) bool {
+	switch v := v.(type) {
+	case Edit:
+		return e.Op > v.Op || e.Op == v.Op && e.Value.Gt(v.Value)
+	default:
+		return false
+	}
+}
+
+// Edits are ordered by Op first and Value second
+func (e Edit) Lt(v any) bool {
+	switch v := v.(type) {
+	case Edit:
+		return e.Op < v.Op || e.Op == v.Op && e.Value.Lt(v.Value)
+	default:
+		return false
+	}
+}
+
+// # Diff
+//
+// Return the edit operations that turn the slice into other, based on their longest common subsequence.
+//
+//	[1,2,3]Diff([1,3,4]) return [keep 1, delete 2, keep 3, insert 4]
+func (sl Slice[T]) Diff(other Slice[T]) Slice[Edit] {
+	n, m := sl.Len(), other.Len()
+
+	// lcs[i][j] is the length of the longest common subsequence of sl[i:] and other[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if sl[i].Eq(other[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make(Slice[Edit], 0, n+m-lcs[0][0])
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case sl[i].Eq(other[j]):
+			edits.Push(Edit{EditKeep, sl[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits.Push(Edit{EditDelete, sl[i]})
+			i++
+		default:
+			edits.Push(Edit{EditInsert, other[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		edits.Push(Edit{EditDelete, sl[i]})
+	}
+	for ; j < m; j++ {
+		edits.Push(Edit{EditInsert, other[j]})
+	}
+	return edits
+}
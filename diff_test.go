@@ -0,0 +1,32 @@
+package sliceutils
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[Int]
+		want      Slice[Edit]
+	}{
+		{
+			Slice[Int]{1, 2, 3, 4}, Slice[Int]{1, 3, 4, 5},
+			Slice[Edit]{{EditKeep, Int(1)}, {EditDelete, Int(2)}, {EditKeep, Int(3)}, {EditKeep, Int(4)}, {EditInsert, Int(5)}},
+		},
+		{
+			Slice[Int]{1, 2}, Slice[Int]{1, 2},
+			Slice[Edit]{{EditKeep, Int(1)}, {EditKeep, Int(2)}},
+		},
+		{
+			Slice[Int]{}, Slice[Int]{7},
+			Slice[Edit]{{EditInsert, Int(7)}},
+		},
+		{
+			Slice[Int]{7}, Slice[Int]{},
+			Slice[Edit]{{EditDelete, Int(7)}},
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.Diff(tt.other); !got.Eq(tt.want) {
+			t.Errorf("%v.Diff(%v) = %v, want %v", tt.sl, tt.other, got, tt.want)
+		}
+	}
+}
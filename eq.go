@@ -1,5 +1,7 @@
 package sliceutils
 
+import "reflect"
+
 // This is the interface you need to implement on your type to be able to utilize this package
 type Eq[T any] interface {
 	Eq(v T) bool
@@ -27,6 +29,44 @@ func (sl Slice[T]) Ne(v any) bool {
 	return !sl.Eq(v)
 }
 
+// # StrictEq
+//
+// Works like Eq, but returns ErrTypeMismatch if two values that are compared have different types,
+// instead of treating them as not equal. Nested slices are compared recursively.
+//
+//	Slice[U]{Int(1), I64(2)}StrictEq(Slice[U]{Int(1), Int(2)}) return false, ErrTypeMismatch
+func (sl Slice[T]) StrictEq(other Slice[T]) (bool, error) {
+	return strictEq(reflect.ValueOf(sl), reflect.ValueOf(other))
+}
+
+func strictEq(a, b reflect.Value) (bool, error) {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid(), nil
+	}
+	if a.Type() != b.Type() {
+		return false, ErrTypeMismatch
+	}
+	if a.Kind() != reflect.Slice {
+		return a.Interface().(Eq[any]).Eq(b.Interface()), nil
+	}
+
+	equal := a.Len() == b.Len()
+	for i := 0; i < min(a.Len(), b.Len()); i++ {
+		eq, err := strictEq(a.Index(i), b.Index(i))
+		if err != nil {
+			return false, err
+		}
+		equal = equal && eq
+	}
+	return equal, nil
+}
+
 // Implementations of Eq on all the builtin types
 func (i Int) Eq(v any) bool {
 	switch vt := v.(type) {
@@ -0,0 +1,32 @@
+package sliceutils
+
+import "testing"
+
+func TestStrictEq(t *testing.T) {
+	tests := []struct {
+		name      string
+		sl, other Slice[U]
+		want      bool
+		err       error
+	}{
+		{"equal", Slice[U]{Int(1), Str("a")}, Slice[U]{Int(1), Str("a")}, true, nil},
+		{"different values", Slice[U]{Int(1), Int(2)}, Slice[U]{Int(1), Int(3)}, false, nil},
+		{"different lengths", Slice[U]{Int(1)}, Slice[U]{Int(1), Int(2)}, false, nil},
+		{"different types", Slice[U]{Int(1), I64(2)}, Slice[U]{Int(1), Int(2)}, false, ErrTypeMismatch},
+		{"nested", Slice[U]{Slice[Int]{1, 2}}, Slice[U]{Slice[Int]{1, 2}}, true, nil},
+		{"nested different types", Slice[U]{Slice[Int]{1}}, Slice[U]{Slice[I8]{1}}, false, ErrTypeMismatch},
+		{"nil values", Slice[U]{nil}, Slice[U]{nil}, true, nil},
+		{"nil and a value", Slice[U]{nil}, Slice[U]{Int(0)}, false, nil},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.StrictEq(tt.other); got != tt.want || err != tt.err {
+			t.Errorf("%s: %v.StrictEq(%v) = %v, %v, want %v, %v", tt.name, tt.sl, tt.other, got, err, tt.want, tt.err)
+		}
+	}
+
+	// Eq treats the same values as not equal instead
+	mixed := Slice[U]{Int(1), I64(2)}
+	if mixed.Eq(Slice[U]{Int(1), Int(2)}) {
+		t.Errorf("%v.Eq([1, 2]) = true, want false", mixed)
+	}
+}
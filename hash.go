@@ -0,0 +1,117 @@
+package sliceutils
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"io"
+	"math"
+	"reflect"
+)
+
+// hash
+//
+// Functions for hashing slices by the values they contain.
+
+// Writes a binary representation of v to w, recursing into nested slices.
+// Values that are equal according to the builtin types' Eq have the same representation.
+func writeValue(w io.Writer, v reflect.Value) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buf[:], u)
+		w.Write(buf[:])
+	}
+	writeFloat := func(f float64) {
+		// -0 and 0 are equal, so they need to be written the same
+		if f == 0 {
+			f = 0
+		}
+		writeUint(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		w.Write([]byte{0})
+	case reflect.Slice:
+		w.Write([]byte{'['})
+		writeUint(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			writeValue(w, v.Index(i))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(real(v.Complex()))
+		writeFloat(imag(v.Complex()))
+	case reflect.Bool:
+		if v.Bool() {
+			w.Write([]byte{1})
+		} else {
+			w.Write([]byte{0})
+		}
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		io.WriteString(w, v.String())
+	default:
+		fmt.Fprintf(w, "%v", v.Interface())
+	}
+}
+
+// # Hash
+//
+// Return a 64-bit FNV-1a hash of the values in the slice, recursing into nested slices.
+// Slices that are equal according to Eq have the same hash, so it can be used as a map key
+// for sets of slices or for memoization. Different slices usually have different hashes.
+//
+// Values that are not of the builtin types are hashed by their %v formatting.
+func (sl Slice[T]) Hash() uint64 {
+	return hashValue(reflect.ValueOf(sl))
+}
+
+// Returns the FNV-1a hash of the binary representation of v.
+func hashValue(v reflect.Value) uint64 {
+	h := fnv.New64a()
+	writeValue(h, v)
+	return h.Sum64()
+}
+
+// # Checksum
+//
+// Return a CRC-32 checksum of the values in the slice. Nested slices are folded in by their own checksum.
+// Slices that are equal according to Eq have the same checksum, so it can be used to cheaply detect
+// whether a slice has changed without storing a copy of it.
+//
+// The checksum is meant for change detection only. It is not suitable for cryptographic use.
+func (sl Slice[T]) Checksum() uint32 {
+	return checksum(reflect.ValueOf(sl))
+}
+
+// Returns the CRC-32 checksum of the slice v, where nested slices are written as their own checksum.
+func checksum(v reflect.Value) uint32 {
+	h := crc32.NewIEEE()
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], uint32(v.Len()))
+	h.Write(buf[:])
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		if elem.Kind() == reflect.Interface {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Slice {
+			binary.LittleEndian.PutUint32(buf[:], checksum(elem))
+			h.Write([]byte{'['})
+			h.Write(buf[:])
+		} else {
+			writeValue(h, elem)
+		}
+	}
+	return h.Sum32()
+}
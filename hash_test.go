@@ -0,0 +1,73 @@
+package sliceutils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	if a, b := (Slice[Int]{1, 2, 3}).Hash(), (Slice[Int]{1, 2, 3}).Hash(); a != b {
+		t.Errorf("equal slices have different hashes %v and %v", a, b)
+	}
+	if a, b := (Slice[F64]{0}).Hash(), (Slice[F64]{F64(math.Copysign(0, -1))}).Hash(); a != b {
+		t.Errorf("[0] and [-0] have different hashes %v and %v", a, b)
+	}
+
+	nested := Slice[Slice[Int]]{{1, 2}, {3}}
+	if a, b := nested.Hash(), (Slice[Slice[Int]]{{1, 2}, {3}}).Hash(); a != b {
+		t.Errorf("equal nested slices have different hashes %v and %v", a, b)
+	}
+
+	distinct := []uint64{
+		Slice[Int]{1, 2, 3}.Hash(),
+		Slice[Int]{3, 2, 1}.Hash(),
+		Slice[Int]{1, 2}.Hash(),
+		Slice[Int]{}.Hash(),
+		nested.Hash(),
+		Slice[Slice[Int]]{{1}, {2, 3}}.Hash(),
+		Slice[Str]{"ab", "c"}.Hash(),
+		Slice[Str]{"a", "bc"}.Hash(),
+	}
+	seen := make(map[uint64]int)
+	for i, h := range distinct {
+		if j, ok := seen[h]; ok {
+			t.Errorf("slices %v and %v have the same hash %v", j, i, h)
+		}
+		seen[h] = i
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	before := sl.Checksum()
+	if after := (Slice[Int]{1, 2, 3}).Checksum(); after != before {
+		t.Errorf("equal slices have different checksums %v and %v", before, after)
+	}
+
+	sl[1] = 5
+	if after := sl.Checksum(); after == before {
+		t.Errorf("Checksum() did not change after a value was changed")
+	}
+	sl[1] = 2
+	if after := sl.Checksum(); after != before {
+		t.Errorf("Checksum() = %v after the value was changed back, want %v", after, before)
+	}
+
+	distinct := []uint32{
+		Slice[Int]{1, 2, 3}.Checksum(),
+		Slice[Int]{3, 2, 1}.Checksum(),
+		Slice[Int]{1, 2}.Checksum(),
+		Slice[Int]{}.Checksum(),
+		Slice[Slice[Int]]{{1, 2}, {3}}.Checksum(),
+		Slice[Slice[Int]]{{1}, {2, 3}}.Checksum(),
+		Slice[Str]{"ab", "c"}.Checksum(),
+		Slice[Str]{"a", "bc"}.Checksum(),
+	}
+	seen := make(map[uint32]int)
+	for i, c := range distinct {
+		if j, ok := seen[c]; ok {
+			t.Errorf("slices %v and %v have the same checksum %v", j, i, c)
+		}
+		seen[c] = i
+	}
+}
@@ -0,0 +1,53 @@
+package sliceutils
+
+import "iter"
+
+// iter
+//
+// Iterators for use with range-over-func.
+
+// # RIter
+//
+// Return an iterator that yields the elements from last to first without copying the slice.
+//
+//	for v := range [1,2,3]RIter() yields 3, 2, 1
+func (sl Slice[T]) RIter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := sl.Len() - 1; i >= 0; i-- {
+			if !yield(sl[i]) {
+				return
+			}
+		}
+	}
+}
+
+// # Values
+//
+// Return an iterator that yields the elements from first to last without copying the slice.
+//
+//	for v := range [1,2,3]Values() yields 1, 2, 3
+func (sl Slice[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range sl {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// # Indexed
+//
+// Return an iterator that yields the index and element pairs from first to last without copying the slice.
+// This is what slices.All returns, but the name All is kept for the boolean predicate.
+//
+//	for i, v := range [1,2,3]Indexed() yields (0, 1), (1, 2), (2, 3)
+func (sl Slice[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range sl {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package sliceutils
+
+import "testing"
+
+func TestRIter(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4}
+	var got Slice[Int]
+	for v := range sl.RIter() {
+		got.Push(v)
+	}
+	if !got.Eq(Slice[Int]{4, 3, 2, 1}) {
+		t.Errorf("RIter() yielded %v, want [4, 3, 2, 1]", got)
+	}
+
+	got.Clear()
+	for v := range sl.RIter() {
+		got.Push(v)
+		if v == 3 {
+			break
+		}
+	}
+	if !got.Eq(Slice[Int]{4, 3}) {
+		t.Errorf("RIter() with break yielded %v, want [4, 3]", got)
+	}
+}
+
+func TestValues(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4}
+	var got Slice[Int]
+	for v := range sl.Values() {
+		got.Push(v)
+	}
+	if !got.Eq(sl) {
+		t.Errorf("Values() yielded %v, want %v", got, sl)
+	}
+
+	// Breaking out of the loop stops the iterator
+	got = Slice[Int]{}
+	for v := range sl.Values() {
+		if v == 3 {
+			break
+		}
+		got.Push(v)
+	}
+	if !got.Eq(Slice[Int]{1, 2}) {
+		t.Errorf("Values() with a break at 3 yielded %v, want [1, 2]", got)
+	}
+}
+
+func TestIndexed(t *testing.T) {
+	sl := Slice[Str]{"a", "b", "c"}
+	var indexes Slice[Int]
+	var values Slice[Str]
+	for i, v := range sl.Indexed() {
+		indexes.Push(Int(i))
+		values.Push(v)
+	}
+	if !indexes.Eq(Slice[Int]{0, 1, 2}) || !values.Eq(sl) {
+		t.Errorf("Indexed() yielded %v and %v, want [0, 1, 2] and %v", indexes, values, sl)
+	}
+
+	calls := 0
+	for i := range sl.Indexed() {
+		calls++
+		if i == 1 {
+			break
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Indexed() with a break at index 1 yielded %v times, want 2", calls)
+	}
+}
@@ -0,0 +1,76 @@
+package sliceutils
+
+import "encoding/json"
+
+// json
+//
+// Functions for encoding slices to and decoding slices from JSON.
+//
+// Slices are encoded as JSON arrays, with nested slices as nested arrays. A nil slice is encoded as null
+// and an empty slice as [], and decoding null gives back a nil slice. The builtin types are encoded as
+// JSON numbers, strings and booleans, except for C64 and C128 which are encoded as [real, imag].
+
+// # MarshalJSON
+//
+// Implementation of json.Marshaler on Slice[T]
+//
+//	[1,2,3]MarshalJSON() return "[1,2,3]"
+func (sl Slice[T]) MarshalJSON() ([]byte, error) {
+	if sl == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal([]T(sl))
+}
+
+// # UnmarshalJSON
+//
+// Implementation of json.Unmarshaler on Slice[T]
+//
+//	Slice[Int]UnmarshalJSON("[1,2,3]") -> [1,2,3]
+func (sl *Slice[T]) UnmarshalJSON(data []byte) error {
+	if sl == nil {
+		return ErrIsNil
+	}
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*sl = values
+	return nil
+}
+
+// U8 and Byte need to implement json.Marshaler, otherwise a slice of them is encoded as a base64 string
+
+func (u U8) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint8(u))
+}
+
+func (b Byte) MarshalJSON() ([]byte, error) {
+	return json.Marshal(byte(b))
+}
+
+func (c C64) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]float32{real(c), imag(c)})
+}
+
+func (c *C64) UnmarshalJSON(data []byte) error {
+	var parts [2]float32
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	*c = C64(complex(parts[0], parts[1]))
+	return nil
+}
+
+func (c C128) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]float64{real(c), imag(c)})
+}
+
+func (c *C128) UnmarshalJSON(data []byte) error {
+	var parts [2]float64
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	*c = C128(complex(parts[0], parts[1]))
+	return nil
+}
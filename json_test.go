@@ -0,0 +1,65 @@
+package sliceutils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		sl   any
+		want string
+	}{
+		{Slice[Int]{1, 2, 3}, "[1,2,3]"},
+		{Slice[U8]{1, 255}, "[1,255]"},
+		{Slice[Byte]{7}, "[7]"},
+		{Slice[Str]{"a", "b"}, `["a","b"]`},
+		{Slice[F64]{1.5}, "[1.5]"},
+		{Slice[C128]{complex(1, -2)}, "[[1,-2]]"},
+		{Slice[Slice[Int]]{{1}, {2, 3}}, "[[1],[2,3]]"},
+		{Slice[Int]{}, "[]"},
+		{Slice[Int](nil), "null"},
+	}
+	for _, tt := range tests {
+		if got, err := json.Marshal(tt.sl); string(got) != tt.want || err != nil {
+			t.Errorf("json.Marshal(%v) = %s, %v, want %s", tt.sl, got, err, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var ints Slice[Int]
+	if err := json.Unmarshal([]byte("[1,2,3]"), &ints); err != nil || !ints.Eq(Slice[Int]{1, 2, 3}) {
+		t.Errorf("json.Unmarshal([1,2,3]) -> %v, %v, want [1, 2, 3]", ints, err)
+	}
+	var strs Slice[Str]
+	if err := json.Unmarshal([]byte("[1]"), &strs); err == nil {
+		t.Errorf("json.Unmarshal([1]) into Slice[Str] -> %v, want an error", strs)
+	}
+
+	// Values survive a round trip
+	bytes := Slice[U8]{0, 128, 255}
+	complexes := Slice[C64]{complex(1, 2), complex(-0.5, 0)}
+	nested := Slice[Slice[Str]]{{"a"}, {}, {"b", "c"}}
+
+	var gotBytes Slice[U8]
+	data, _ := json.Marshal(bytes)
+	if err := json.Unmarshal(data, &gotBytes); err != nil || !gotBytes.Eq(bytes) {
+		t.Errorf("round trip of %v -> %v, %v", bytes, gotBytes, err)
+	}
+	var gotComplexes Slice[C64]
+	data, _ = json.Marshal(complexes)
+	if err := json.Unmarshal(data, &gotComplexes); err != nil || !gotComplexes.Eq(complexes) {
+		t.Errorf("round trip of %v -> %v, %v", complexes, gotComplexes, err)
+	}
+	var gotNested Slice[Slice[Str]]
+	data, _ = json.Marshal(nested)
+	if err := json.Unmarshal(data, &gotNested); err != nil || !gotNested.Eq(nested) {
+		t.Errorf("round trip of %v -> %v, %v", nested, gotNested, err)
+	}
+
+	var sl *Slice[Int]
+	if err := sl.UnmarshalJSON([]byte("[1]")); err != ErrIsNil {
+		t.Errorf("UnmarshalJSON() on nil returned %v, want %v", err, ErrIsNil)
+	}
+}
@@ -13,6 +13,31 @@ func (sl *Slice[T]) Clear() {
 	*sl = New[T]()
 }
 
+// # Truncate
+//
+// Shorten the slice to at most n elements. Does nothing if the slice is already shorter.
+//
+//	[1,2,3,4]Truncate(2) -> [1,2]
+func (sl *Slice[T]) Truncate(n uint) {
+	if sl.Len() > int(n) {
+		*sl = (*sl)[:n]
+	}
+}
+
+// # Resize
+//
+// Truncate the slice or append default values to it, so that it has exactly n elements.
+//
+//	[1,2,3]Resize(2) -> [1,2]
+//	[1,2,3]Resize(5) -> [1,2,3,0,0]
+func (sl *Slice[T]) Resize(n uint) {
+	if sl.Len() >= int(n) {
+		sl.Truncate(n)
+		return
+	}
+	*sl = append(*sl, make(Slice[T], int(n)-sl.Len())...)
+}
+
 // # Dedup
 //
 // Remove duplicate values in the slice.
@@ -28,6 +53,21 @@ func (sl *Slice[T]) Dedup() {
 	*sl = seen
 }
 
+// # DedupByEq
+//
+// Remove values that are duplicates according to the provided function eq, keeping the first of each.
+//
+//	["a","A","b"]DedupByEq(func(a, b T) bool {return strings.EqualFold(string(a), string(b))}) return ["a","b"]
+func (sl *Slice[T]) DedupByEq(eq func(a, b T) bool) {
+	seen := Slice[T]{}
+	for _, value := range *sl {
+		if !seen.Any(func(v T) bool { return eq(v, value) }) {
+			seen.Push(value)
+		}
+	}
+	*sl = seen
+}
+
 // # Fill
 //
 // Replace all the values with value of type T.
@@ -45,7 +85,7 @@ func (sl Slice[T]) Fill(value T) {
 //
 //	[1,2,3]FillWith({return 1}) return [1,1,1]
 func (sl Slice[T]) FillWith(f func() T) {
-	for i := 0; i < sl.Len()-1; i++ {
+	for i := 0; i < sl.Len(); i++ {
 		sl[i] = f()
 	}
 }
@@ -55,12 +95,50 @@ func (sl Slice[T]) FillWith(f func() T) {
 // Replace all values with the default value of T
 //
 //	[1,2,3]FillWithDefault() return [0,0,0]
-func (sl Slice[T]) FillWithDefault(f func() T) {
-	for i := 0; i < sl.Len()-1; i++ {
+func (sl Slice[T]) FillWithDefault() {
+	for i := 0; i < sl.Len(); i++ {
 		sl[i] = sl.Default()
 	}
 }
 
+// # FillForward
+//
+// Replace all default values with the closest non-default value before it.
+// Default values at the start of the slice have no value before them and are kept as-is.
+//
+//	[0,1,0,0,2,0]FillForward() -> [0,1,1,1,2,2]
+func (sl Slice[T]) FillForward() {
+	var last T
+	found := false
+	for i, value := range sl {
+		if !value.Eq(sl.Default()) {
+			last = value
+			found = true
+		} else if found {
+			sl[i] = last
+		}
+	}
+}
+
+// # FillBackward
+//
+// Replace all default values with the closest non-default value after it.
+// Default values at the end of the slice have no value after them and are kept as-is.
+//
+//	[0,1,0,0,2,0]FillBackward() -> [1,1,2,2,2,0]
+func (sl Slice[T]) FillBackward() {
+	var next T
+	found := false
+	for i := sl.Len() - 1; i >= 0; i-- {
+		if !sl[i].Eq(sl.Default()) {
+			next = sl[i]
+			found = true
+		} else if found {
+			sl[i] = next
+		}
+	}
+}
+
 // # Replace
 //
 // Replace the value at index n and return the replaced value
@@ -88,6 +166,22 @@ func (sl Slice[T]) ReverseMut() Slice[T] {
 	return sl
 }
 
+// # ReverseRange
+//
+// Reverse the elements from index from up to, but not including, index to.
+// Negative indexes count from the end of the slice.
+//
+//	[1,2,3,4,5]ReverseRange(1,4) -> [1,4,3,2,5]
+//	[1,2,3,4,5]ReverseRange(-3,5) -> [1,2,5,4,3]
+func (sl Slice[T]) ReverseRange(from, to int) error {
+	from, to = sl.normalizeIndex(from), sl.normalizeIndex(to)
+	if from < 0 || to > sl.Len() || from > to {
+		return ErrOutOfRange
+	}
+	sl[from:to].ReverseMut()
+	return nil
+}
+
 // # RotateLeft
 //
 // Rotate all elements to the left n steps
@@ -123,6 +217,22 @@ func (sl *Slice[T]) RotateRight(n uint) {
 	*sl = append(copy[length-n:], copy[:length-n]...)
 }
 
+// # RotateToFirst
+//
+// Rotate all elements to the left until the first element where f returns true is at index 0.
+// Returns false and leaves the slice unchanged if f returns false for all elements.
+//
+//	[1,2,3,4]RotateToFirst(func(v T) bool {return v == 3}) -> [3,4,1,2] and return true
+func (sl *Slice[T]) RotateToFirst(f func(T) bool) bool {
+	for i, v := range *sl {
+		if f(v) {
+			sl.RotateLeft(uint(i))
+			return true
+		}
+	}
+	return false
+}
+
 // # Set
 //
 // Set the value at index n with value T.
@@ -130,15 +240,10 @@ func (sl *Slice[T]) RotateRight(n uint) {
 //	[1,2,3]Set(1,5) -> [1,5,3]
 //	['a','b','c']Set(1,'z') -> ['a','z','c']
 func (sl Slice[T]) Set(n int, value T) {
-	if sl.Len() < n {
+	n = sl.normalizeIndex(n)
+	if n < 0 || n >= sl.Len() {
 		return
 	}
-	if n < 0 {
-		for n < 0 {
-			n += sl.Len()
-		}
-		n++
-	}
 	sl[n] = value
 }
 
@@ -158,9 +263,9 @@ func (sl Slice[T]) Swap(n1, n2 uint) {
 //
 // Swap all values v1 with v2 in the slice
 //
-//	[1,1,2,2,3,3]Swap(1,3) -> [3,3,2,2,1,1]
+//	[1,1,2,2,3,3]SwapValues(1,3) -> [3,3,2,2,1,1]
 func (sl Slice[T]) SwapValues(v1, v2 T) {
-	if !sl.Contains(v1) || sl.Contains(v2) {
+	if v1.Eq(v2) {
 		return
 	}
 	for i, value := range sl {
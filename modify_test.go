@@ -0,0 +1,219 @@
+package sliceutils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFillForward(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{0, 1, 0, 0, 2, 0}, Slice[Int]{0, 1, 1, 1, 2, 2}},
+		{Slice[Int]{5, 0, 0}, Slice[Int]{5, 5, 5}},
+		{Slice[Int]{0, 0}, Slice[Int]{0, 0}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		sl := append(Slice[Int]{}, tt.sl...)
+		sl.FillForward()
+		if !sl.Eq(tt.want) {
+			t.Errorf("%v.FillForward() -> %v, want %v", tt.sl, sl, tt.want)
+		}
+	}
+}
+
+func TestFillBackward(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{0, 1, 0, 0, 2, 0}, Slice[Int]{1, 1, 2, 2, 2, 0}},
+		{Slice[Int]{0, 0, 5}, Slice[Int]{5, 5, 5}},
+		{Slice[Int]{0, 0}, Slice[Int]{0, 0}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		sl := append(Slice[Int]{}, tt.sl...)
+		sl.FillBackward()
+		if !sl.Eq(tt.want) {
+			t.Errorf("%v.FillBackward() -> %v, want %v", tt.sl, sl, tt.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		n    uint
+		want Slice[Int]
+	}{
+		{Slice[Int]{1, 2, 3, 4}, 2, Slice[Int]{1, 2}},
+		{Slice[Int]{1, 2}, 5, Slice[Int]{1, 2}},
+		{Slice[Int]{1, 2}, 2, Slice[Int]{1, 2}},
+		{Slice[Int]{1, 2}, 0, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		sl := append(Slice[Int]{}, tt.sl...)
+		sl.Truncate(tt.n)
+		if !sl.Eq(tt.want) {
+			t.Errorf("%v.Truncate(%v) -> %v, want %v", tt.sl, tt.n, sl, tt.want)
+		}
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		n    uint
+		want Slice[Int]
+	}{
+		{Slice[Int]{1, 2, 3}, 5, Slice[Int]{1, 2, 3, 0, 0}},
+		{Slice[Int]{1, 2, 3}, 2, Slice[Int]{1, 2}},
+		{Slice[Int]{1, 2, 3}, 3, Slice[Int]{1, 2, 3}},
+		{Slice[Int]{}, 2, Slice[Int]{0, 0}},
+	}
+	for _, tt := range tests {
+		sl := append(Slice[Int]{}, tt.sl...)
+		sl.Resize(tt.n)
+		if !sl.Eq(tt.want) {
+			t.Errorf("%v.Resize(%v) -> %v, want %v", tt.sl, tt.n, sl, tt.want)
+		}
+	}
+}
+
+func TestReverseRange(t *testing.T) {
+	tests := []struct {
+		from, to int
+		want     Slice[Int]
+		err      error
+	}{
+		{1, 4, Slice[Int]{1, 4, 3, 2, 5}, nil},
+		{0, 5, Slice[Int]{5, 4, 3, 2, 1}, nil},
+		{-3, 5, Slice[Int]{1, 2, 5, 4, 3}, nil},
+		{2, 2, Slice[Int]{1, 2, 3, 4, 5}, nil},
+		{3, 1, Slice[Int]{1, 2, 3, 4, 5}, ErrOutOfRange},
+		{0, 6, Slice[Int]{1, 2, 3, 4, 5}, ErrOutOfRange},
+		{-6, 2, Slice[Int]{1, 2, 3, 4, 5}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		sl := Slice[Int]{1, 2, 3, 4, 5}
+		if err := sl.ReverseRange(tt.from, tt.to); err != tt.err || !sl.Eq(tt.want) {
+			t.Errorf("ReverseRange(%v, %v) -> %v, %v, want %v, %v", tt.from, tt.to, sl, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestDedupByEq(t *testing.T) {
+	strs := Slice[Str]{"Go", "go", "Rust", "GO", "rust", "C"}
+	strs.DedupByEq(func(a, b Str) bool { return strings.EqualFold(string(a), string(b)) })
+	if !strs.Eq(Slice[Str]{"Go", "Rust", "C"}) {
+		t.Errorf("DedupByEq(EqualFold) -> %v, want [Go, Rust, C]", strs)
+	}
+
+	floats := Slice[F64]{1, 1.0001, 2, 1.00005, 2.01}
+	floats.DedupByEq(func(a, b F64) bool { return a-b < 0.001 && b-a < 0.001 })
+	if !floats.Eq(Slice[F64]{1, 2, 2.01}) {
+		t.Errorf("DedupByEq(within 0.001) -> %v, want [1, 2, 2.01]", floats)
+	}
+
+	empty := Slice[Int]{}
+	empty.DedupByEq(func(a, b Int) bool { return true })
+	if !empty.IsEmpty() {
+		t.Errorf("DedupByEq() on empty slice -> %v, want []", empty)
+	}
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		n    int
+		want Slice[Int]
+	}{
+		{0, Slice[Int]{9, 2, 3}},
+		{-1, Slice[Int]{1, 2, 9}},
+		{-3, Slice[Int]{9, 2, 3}},
+		{-4, Slice[Int]{1, 2, 3}},
+		{3, Slice[Int]{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		sl := Slice[Int]{1, 2, 3}
+		if sl.Set(tt.n, 9); !sl.Eq(tt.want) {
+			t.Errorf("Set(%v, 9) -> %v, want %v", tt.n, sl, tt.want)
+		}
+	}
+}
+
+func TestSwapValues(t *testing.T) {
+	tests := []struct {
+		sl     Slice[Int]
+		v1, v2 Int
+		want   Slice[Int]
+	}{
+		{Slice[Int]{1, 1, 2, 2, 3, 3}, 1, 3, Slice[Int]{3, 3, 2, 2, 1, 1}},
+		{Slice[Int]{1, 2, 1}, 1, 9, Slice[Int]{9, 2, 9}},
+		{Slice[Int]{1, 2, 1}, 9, 2, Slice[Int]{1, 9, 1}},
+		{Slice[Int]{1, 2}, 1, 1, Slice[Int]{1, 2}},
+		{Slice[Int]{1, 2}, 8, 9, Slice[Int]{1, 2}},
+	}
+	for _, tt := range tests {
+		sl := tt.sl
+		if sl.SwapValues(tt.v1, tt.v2); !sl.Eq(tt.want) {
+			t.Errorf("SwapValues(%v, %v) -> %v, want %v", tt.v1, tt.v2, sl, tt.want)
+		}
+	}
+}
+
+func TestRotateToFirst(t *testing.T) {
+	tests := []struct {
+		target  Int
+		want    Slice[Int]
+		rotated bool
+	}{
+		{3, Slice[Int]{3, 4, 1, 2}, true},
+		{1, Slice[Int]{1, 2, 3, 4}, true},
+		{4, Slice[Int]{4, 1, 2, 3}, true},
+		{9, Slice[Int]{1, 2, 3, 4}, false},
+	}
+	for _, tt := range tests {
+		sl := Slice[Int]{1, 2, 3, 4}
+		rotated := sl.RotateToFirst(func(v Int) bool { return v == tt.target })
+		if rotated != tt.rotated || !sl.Eq(tt.want) {
+			t.Errorf("RotateToFirst(== %v) -> %v and returned %v, want %v and %v", tt.target, sl, rotated, tt.want, tt.rotated)
+		}
+	}
+
+	// The first match is rotated to the front
+	sl := Slice[Int]{1, 2, 1, 2}
+	if sl.RotateToFirst(func(v Int) bool { return v == 2 }); !sl.Eq(Slice[Int]{2, 1, 2, 1}) {
+		t.Errorf("RotateToFirst(== 2) -> %v, want [2, 1, 2, 1]", sl)
+	}
+}
+
+func TestFillWith(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	next := Int(10)
+	sl.FillWith(func() Int {
+		next++
+		return next
+	})
+	if !sl.Eq(Slice[Int]{11, 12, 13}) {
+		t.Errorf("FillWith(counter) -> %v, want [11, 12, 13]", sl)
+	}
+
+	single := Slice[Str]{"a"}
+	if single.FillWith(func() Str { return "z" }); !single.Eq(Slice[Str]{"z"}) {
+		t.Errorf("FillWith(z) -> %v, want [z]", single)
+	}
+}
+
+func TestFillWithDefault(t *testing.T) {
+	ints := Slice[Int]{1, 2, 3}
+	if ints.FillWithDefault(); !ints.Eq(Slice[Int]{0, 0, 0}) {
+		t.Errorf("FillWithDefault() -> %v, want [0, 0, 0]", ints)
+	}
+	strs := Slice[Str]{"a", "b"}
+	if strs.FillWithDefault(); !strs.Eq(Slice[Str]{"", ""}) {
+		t.Errorf("FillWithDefault() -> %v, want [, ]", strs)
+	}
+}
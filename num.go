@@ -0,0 +1,332 @@
+package sliceutils
+
+// # Num
+//
+// Interface for arithmetic on slice values. Used for Sum, Product and DiffN.
+type Num[T any] interface {
+	Add(v2 T) T
+	Sub(v2 T) T
+	Mul(v2 T) T
+}
+
+// # Sum
+//
+// Return the sum of all the values in the slice. Returns the default value of T if the slice is empty.
+//
+//	[1,2,3]Sum() return 6
+//	[]Sum() return 0
+//
+// Returns ErrNotNumeric if T does not implement Num.
+func (sl Slice[T]) Sum() (T, error) {
+	var sum T
+	if _, ok := any(sum).(Num[T]); !ok {
+		return sum, ErrNotNumeric
+	}
+	for _, v := range sl {
+		sum = any(sum).(Num[T]).Add(v)
+	}
+	return sum, nil
+}
+
+// # Product
+//
+// Return the product of all the values in the slice. Returns 1, the multiplicative identity, if the slice is empty.
+//
+//	[1,2,3,4]Product() return 24
+//	[]Product() return 1
+//
+// Returns ErrNotNumeric if T does not implement Num.
+// Integer types wrap around on overflow, the same way as multiplication with the * operator does.
+// An empty slice of a type that is not one of the builtin numeric types has no known 1, so it returns ErrIsEmpty.
+func (sl Slice[T]) Product() (T, error) {
+	if _, ok := any(sl.Default()).(Num[T]); !ok {
+		return sl.Default(), ErrNotNumeric
+	}
+	if sl.IsEmpty() {
+		if unit, ok := one[T](); ok {
+			return unit, nil
+		}
+		return sl.Default(), ErrIsEmpty
+	}
+	product := sl[0]
+	for _, v := range sl[1:] {
+		product = any(product).(Num[T]).Mul(v)
+	}
+	return product, nil
+}
+
+// Returns 1 as T and true if T is one of the integer, float or complex builtin types.
+func one[T Value[any]]() (T, bool) {
+	var zero T
+	switch any(zero).(type) {
+	case Int, I8, I16, I32, I64, Uint, U8, U16, U32, U64, Byte, F32, F64, C64, C128:
+		return fromF64[T](1), true
+	default:
+		return zero, false
+	}
+}
+
+// # CumulativeSum
+//
+// Return a slice with the sum of all values up to and including each index.
+//
+//	[1,2,3]CumulativeSum() return [1,3,6]
+//
+// # Caution!
+//
+// Panics if T does not implement Num
+func (sl Slice[T]) CumulativeSum() Slice[T] {
+	if _, ok := any(sl.Default()).(Num[T]); !ok {
+		panic("values of the slice need to implement Num")
+	}
+	cum := make(Slice[T], sl.Len())
+	for i, v := range sl {
+		if i == 0 {
+			cum[i] = v
+			continue
+		}
+		cum[i] = any(cum[i-1]).(Num[T]).Add(v)
+	}
+	return cum
+}
+
+// # CumulativeProduct
+//
+// Return a slice with the product of all values up to and including each index.
+// Same as CumProduct, but panics instead of returning an error, like CumulativeSum does.
+//
+//	[1,2,3,4]CumulativeProduct() return [1,2,6,24]
+//
+// # Caution!
+//
+// Panics if T does not implement Num
+func (sl Slice[T]) CumulativeProduct() Slice[T] {
+	cum, err := sl.CumProduct()
+	if err != nil {
+		panic("values of the slice need to implement Num")
+	}
+	return cum
+}
+
+// # Average
+//
+// Return the arithmetic mean of the values in the slice.
+// The integer and float types are summed as F64, so the sum of small integer types does not overflow and the result is not truncated.
+// C64 and C128 are summed in T with Add, the same way as Sum does, and the real part of the mean is returned.
+//
+//	[1,2,3,4]Average() return 2.5
+//	[(1+2i),(3-2i)]Average() return 2
+//
+// Returns ErrIsEmpty if the slice is empty and ErrNotNumeric if T is not one of the integer, float or complex types.
+// Returns the real part and ErrInvalidArgument if the mean of a complex slice has an imaginary part, since it does not fit in an F64.
+func (sl Slice[T]) Average() (F64, error) {
+	if _, ok := any(sl.Default()).(Num[T]); !ok {
+		return 0, ErrNotNumeric
+	}
+	if sl.IsEmpty() {
+		return 0, ErrIsEmpty
+	}
+	if floats, err := sl.floats(); err == nil {
+		sum := 0.0
+		for _, f := range floats {
+			sum += f
+		}
+		return F64(sum / float64(len(floats))), nil
+	}
+	sum, _ := sl.Sum()
+	var mean complex128
+	switch v := any(sum).(type) {
+	case C64:
+		mean = complex128(v) / complex(float64(sl.Len()), 0)
+	case C128:
+		mean = complex128(v) / complex(float64(sl.Len()), 0)
+	default:
+		return 0, ErrNotNumeric
+	}
+	if imag(mean) != 0 {
+		return F64(real(mean)), ErrInvalidArgument
+	}
+	return F64(real(mean)), nil
+}
+
+// Add, Sub and Mul implementations for the integer, float and complex builtin types
+
+func (v Int) Add(v2 Int) Int {
+	return v + v2
+}
+
+func (v I8) Add(v2 I8) I8 {
+	return v + v2
+}
+
+func (v I16) Add(v2 I16) I16 {
+	return v + v2
+}
+
+func (v I32) Add(v2 I32) I32 {
+	return v + v2
+}
+
+func (v I64) Add(v2 I64) I64 {
+	return v + v2
+}
+
+func (v Uint) Add(v2 Uint) Uint {
+	return v + v2
+}
+
+func (v U8) Add(v2 U8) U8 {
+	return v + v2
+}
+
+func (v U16) Add(v2 U16) U16 {
+	return v + v2
+}
+
+func (v U32) Add(v2 U32) U32 {
+	return v + v2
+}
+
+func (v U64) Add(v2 U64) U64 {
+	return v + v2
+}
+
+func (v Byte) Add(v2 Byte) Byte {
+	return v + v2
+}
+
+func (v F32) Add(v2 F32) F32 {
+	return v + v2
+}
+
+func (v F64) Add(v2 F64) F64 {
+	return v + v2
+}
+
+func (v C64) Add(v2 C64) C64 {
+	return v + v2
+}
+
+func (v C128) Add(v2 C128) C128 {
+	return v + v2
+}
+
+func (v Int) Sub(v2 Int) Int {
+	return v - v2
+}
+
+func (v I8) Sub(v2 I8) I8 {
+	return v - v2
+}
+
+func (v I16) Sub(v2 I16) I16 {
+	return v - v2
+}
+
+func (v I32) Sub(v2 I32) I32 {
+	return v - v2
+}
+
+func (v I64) Sub(v2 I64) I64 {
+	return v - v2
+}
+
+func (v Uint) Sub(v2 Uint) Uint {
+	return v - v2
+}
+
+func (v U8) Sub(v2 U8) U8 {
+	return v - v2
+}
+
+func (v U16) Sub(v2 U16) U16 {
+	return v - v2
+}
+
+func (v U32) Sub(v2 U32) U32 {
+	return v - v2
+}
+
+func (v U64) Sub(v2 U64) U64 {
+	return v - v2
+}
+
+func (v Byte) Sub(v2 Byte) Byte {
+	return v - v2
+}
+
+func (v F32) Sub(v2 F32) F32 {
+	return v - v2
+}
+
+func (v F64) Sub(v2 F64) F64 {
+	return v - v2
+}
+
+func (v C64) Sub(v2 C64) C64 {
+	return v - v2
+}
+
+func (v C128) Sub(v2 C128) C128 {
+	return v - v2
+}
+
+func (v Int) Mul(v2 Int) Int {
+	return v * v2
+}
+
+func (v I8) Mul(v2 I8) I8 {
+	return v * v2
+}
+
+func (v I16) Mul(v2 I16) I16 {
+	return v * v2
+}
+
+func (v I32) Mul(v2 I32) I32 {
+	return v * v2
+}
+
+func (v I64) Mul(v2 I64) I64 {
+	return v * v2
+}
+
+func (v Uint) Mul(v2 Uint) Uint {
+	return v * v2
+}
+
+func (v U8) Mul(v2 U8) U8 {
+	return v * v2
+}
+
+func (v U16) Mul(v2 U16) U16 {
+	return v * v2
+}
+
+func (v U32) Mul(v2 U32) U32 {
+	return v * v2
+}
+
+func (v U64) Mul(v2 U64) U64 {
+	return v * v2
+}
+
+func (v Byte) Mul(v2 Byte) Byte {
+	return v * v2
+}
+
+func (v F32) Mul(v2 F32) F32 {
+	return v * v2
+}
+
+func (v F64) Mul(v2 F64) F64 {
+	return v * v2
+}
+
+func (v C64) Mul(v2 C64) C64 {
+	return v * v2
+}
+
+func (v C128) Mul(v2 C128) C128 {
+	return v * v2
+}
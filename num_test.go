@@ -0,0 +1,218 @@
+package sliceutils
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	if got, err := (Slice[Int]{1, 2, 3}).Sum(); got != 6 || err != nil {
+		t.Errorf("[1, 2, 3].Sum() = %v, %v, want 6", got, err)
+	}
+	if got, err := (Slice[F64]{0.5, 1.25, -1}).Sum(); got != 0.75 || err != nil {
+		t.Errorf("[0.5, 1.25, -1].Sum() = %v, %v, want 0.75", got, err)
+	}
+	if got, err := (Slice[C128]{complex(1, 2), complex(3, -1)}).Sum(); got != C128(complex(4, 1)) || err != nil {
+		t.Errorf("[(1+2i), (3-1i)].Sum() = %v, %v, want (4+1i)", got, err)
+	}
+	if got, err := (Slice[I64]{1<<53 + 1, 1}).Sum(); got != 1<<53+2 || err != nil {
+		t.Errorf("[%v, 1].Sum() = %v, %v, want %v", 1<<53+1, got, err, 1<<53+2)
+	}
+	if got, err := (Slice[Int]{}).Sum(); got != 0 || err != nil {
+		t.Errorf("[].Sum() = %v, %v, want 0", got, err)
+	}
+	if _, err := (Slice[Str]{"a"}).Sum(); err != ErrNotNumeric {
+		t.Errorf("Sum() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+// mod7 is a custom Num type, used to check that the arithmetic does not depend on the builtin types.
+type mod7 int
+
+func (m mod7) Eq(v any) bool {
+	other, ok := v.(mod7)
+	return ok && m == other
+}
+
+func (m mod7) Gt(v any) bool {
+	other, ok := v.(mod7)
+	return ok && m > other
+}
+
+func (m mod7) Lt(v any) bool {
+	other, ok := v.(mod7)
+	return ok && m < other
+}
+
+func (m mod7) Add(v2 mod7) mod7 {
+	return (m + v2) % 7
+}
+
+func (m mod7) Sub(v2 mod7) mod7 {
+	return (m - v2 + 7) % 7
+}
+
+func (m mod7) Mul(v2 mod7) mod7 {
+	return (m * v2) % 7
+}
+
+func TestProduct(t *testing.T) {
+	if got, err := (Slice[Int]{1, 2, 3, 4}).Product(); got != 24 || err != nil {
+		t.Errorf("[1, 2, 3, 4].Product() = %v, %v, want 24", got, err)
+	}
+	if got, err := (Slice[F64]{0.5, 3}).Product(); got != 1.5 || err != nil {
+		t.Errorf("[0.5, 3].Product() = %v, %v, want 1.5", got, err)
+	}
+	if got, err := (Slice[C128]{complex(0, 1), complex(0, 1)}).Product(); got != -1 || err != nil {
+		t.Errorf("[(0+1i), (0+1i)].Product() = %v, %v, want (-1+0i)", got, err)
+	}
+	if got, err := (Slice[U8]{16, 17}).Product(); got != 16 || err != nil {
+		t.Errorf("[16, 17].Product() = %v, %v, want 16", got, err)
+	}
+	if got, err := (Slice[mod7]{3, 5, 4}).Product(); got != 4 || err != nil {
+		t.Errorf("[3, 5, 4].Product() on mod7 = %v, %v, want 4", got, err)
+	}
+
+	if got, err := (Slice[Int]{}).Product(); got != 1 || err != nil {
+		t.Errorf("[].Product() = %v, %v, want 1", got, err)
+	}
+	if got, err := (Slice[C64]{}).Product(); got != 1 || err != nil {
+		t.Errorf("[].Product() on C64 = %v, %v, want (1+0i)", got, err)
+	}
+	if _, err := (Slice[mod7]{}).Product(); err != ErrIsEmpty {
+		t.Errorf("[].Product() on mod7 returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a"}).Product(); err != ErrNotNumeric {
+		t.Errorf("Product() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tests := []struct {
+		name string
+		sl   any
+		want F64
+	}{
+		{"Int", Slice[Int]{1, 2, 3, 4}, 2.5},
+		{"F64", Slice[F64]{0.5, 1.5, -1}, 1.0 / 3},
+		{"I8 without overflow", Slice[I8]{100, 100}, 100},
+		{"U8 without overflow", Slice[U8]{200, 250}, 225},
+		{"I8 negative", Slice[I8]{-128, -128, -128}, -128},
+	}
+	for _, tt := range tests {
+		var got F64
+		var err error
+		switch sl := tt.sl.(type) {
+		case Slice[Int]:
+			got, err = sl.Average()
+		case Slice[F64]:
+			got, err = sl.Average()
+		case Slice[I8]:
+			got, err = sl.Average()
+		case Slice[U8]:
+			got, err = sl.Average()
+		}
+		if got != tt.want || err != nil {
+			t.Errorf("%s: %v.Average() = %v, %v, want %v", tt.name, tt.sl, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[Int]{}).Average(); err != ErrIsEmpty {
+		t.Errorf("Average() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a"}).Average(); err != ErrNotNumeric {
+		t.Errorf("Average() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+	if _, err := (Slice[mod7]{1}).Average(); err != ErrNotNumeric {
+		t.Errorf("Average() on mod7 returned %v, want %v", err, ErrNotNumeric)
+	}
+
+	// Complex slices are summed with Add and the real part of the mean is returned
+	complexTests := []struct {
+		name string
+		sl   any
+		want F64
+		err  error
+	}{
+		{"C128 real mean", Slice[C128]{complex(1, 2), complex(3, -2)}, 2, nil},
+		{"C64 real mean", Slice[C64]{complex(1, 0), complex(2, 0)}, 1.5, nil},
+		{"C128 imaginary mean", Slice[C128]{complex(1, 2), complex(3, 2)}, 2, ErrInvalidArgument},
+		{"C128 empty", Slice[C128]{}, 0, ErrIsEmpty},
+	}
+	for _, tt := range complexTests {
+		var got F64
+		var err error
+		switch sl := tt.sl.(type) {
+		case Slice[C64]:
+			got, err = sl.Average()
+		case Slice[C128]:
+			got, err = sl.Average()
+		}
+		if got != tt.want || err != tt.err {
+			t.Errorf("%s: %v.Average() = %v, %v, want %v, %v", tt.name, tt.sl, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestCumulativeSum(t *testing.T) {
+	if got := (Slice[Int]{1, 2, 3}).CumulativeSum(); !got.Eq(Slice[Int]{1, 3, 6}) {
+		t.Errorf("[1, 2, 3].CumulativeSum() = %v, want [1, 3, 6]", got)
+	}
+	if got := (Slice[F64]{0.5, -1, 2}).CumulativeSum(); !got.Eq(Slice[F64]{0.5, -0.5, 1.5}) {
+		t.Errorf("[0.5, -1, 2].CumulativeSum() = %v, want [0.5, -0.5, 1.5]", got)
+	}
+	if got := (Slice[mod7]{3, 5, 6}).CumulativeSum(); !got.Eq(Slice[mod7]{3, 1, 0}) {
+		t.Errorf("[3, 5, 6].CumulativeSum() on mod7 = %v, want [3, 1, 0]", got)
+	}
+	if got := (Slice[Int]{}).CumulativeSum(); !got.IsEmpty() {
+		t.Errorf("[].CumulativeSum() = %v, want []", got)
+	}
+
+	// The last value is the same as Sum
+	sl := Slice[I64]{1<<53 + 1, 2, -5}
+	sum, _ := sl.Sum()
+	if got := sl.CumulativeSum(); got[sl.Len()-1] != sum {
+		t.Errorf("%v.CumulativeSum() = %v, want the last value to be %v", sl, got, sum)
+	}
+}
+
+func TestCumulativeProduct(t *testing.T) {
+	if got := (Slice[Int]{1, 2, 3, 4}).CumulativeProduct(); !got.Eq(Slice[Int]{1, 2, 6, 24}) {
+		t.Errorf("[1, 2, 3, 4].CumulativeProduct() = %v, want [1, 2, 6, 24]", got)
+	}
+	if got := (Slice[C128]{complex(0, 1), complex(0, 1)}).CumulativeProduct(); !got.Eq(Slice[C128]{complex(0, 1), -1}) {
+		t.Errorf("[(0+1i), (0+1i)].CumulativeProduct() = %v, want [(0+1i), (-1+0i)]", got)
+	}
+	if got := (Slice[mod7]{3, 5, 4}).CumulativeProduct(); !got.Eq(Slice[mod7]{3, 1, 4}) {
+		t.Errorf("[3, 5, 4].CumulativeProduct() on mod7 = %v, want [3, 1, 4]", got)
+	}
+	if got := (Slice[Int]{}).CumulativeProduct(); !got.IsEmpty() {
+		t.Errorf("[].CumulativeProduct() = %v, want []", got)
+	}
+
+	// The last value is the same as Product
+	sl := Slice[Int]{2, -3, 5}
+	product, _ := sl.Product()
+	if got := sl.CumulativeProduct(); got[sl.Len()-1] != product {
+		t.Errorf("%v.CumulativeProduct() = %v, want the last value to be %v", sl, got, product)
+	}
+}
+
+func TestCumulativePanics(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"CumulativeSum", func() { Slice[Str]{"a"}.CumulativeSum() }},
+		{"CumulativeSum on empty slice", func() { Slice[Str]{}.CumulativeSum() }},
+		{"CumulativeProduct", func() { Slice[Str]{"a"}.CumulativeProduct() }},
+		{"CumulativeProduct on empty slice", func() { Slice[Str]{}.CumulativeProduct() }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s on Str did not panic", tt.name)
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
@@ -0,0 +1,593 @@
+package sliceutils
+
+import (
+	"math"
+	"sort"
+)
+
+// numeric
+//
+// Functions for slices of the integer and float types.
+
+// Returns v as a float64 if v is one of the integer or float types.
+func toF64(v any) (float64, bool) {
+	switch vt := v.(type) {
+	case F32:
+		return float64(vt), true
+	case F64:
+		return float64(vt), true
+	case Uint:
+		return float64(vt), true
+	case U64:
+		return float64(vt), true
+	default:
+		i, ok := toInt64(v)
+		return float64(i), ok
+	}
+}
+
+// Returns the values of the slice as float64s, or ErrNotNumeric if T is not one of the integer or float types.
+func (sl Slice[T]) floats() ([]float64, error) {
+	if _, ok := toF64(sl.Default()); !ok {
+		return nil, ErrNotNumeric
+	}
+	floats := make([]float64, sl.Len())
+	for i, v := range sl {
+		floats[i], _ = toF64(v)
+	}
+	return floats, nil
+}
+
+// Returns v as an int64 if v is one of the integer types and the value fits in an int64.
+func toInt64(v any) (int64, bool) {
+	switch vt := v.(type) {
+	case Int:
+		return int64(vt), true
+	case I8:
+		return int64(vt), true
+	case I16:
+		return int64(vt), true
+	case I32:
+		return int64(vt), true
+	case I64:
+		return int64(vt), true
+	case Uint:
+		return int64(vt), uint64(vt) <= math.MaxInt64
+	case U8:
+		return int64(vt), true
+	case U16:
+		return int64(vt), true
+	case U32:
+		return int64(vt), true
+	case U64:
+		return int64(vt), uint64(vt) <= math.MaxInt64
+	case Byte:
+		return int64(vt), true
+	default:
+		return 0, false
+	}
+}
+
+// # NearestIndex
+//
+// Return the index of the value closest to target. If two values are equally close, the first one is returned.
+//
+//	[1,5,9]NearestIndex(6) return 1
+//	[1,5,9]NearestIndex(3) return 0
+func (sl Slice[T]) NearestIndex(target T) (int, error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return -1, err
+	}
+	if sl.IsEmpty() {
+		return -1, ErrIsEmpty
+	}
+	t, _ := toF64(target)
+	nearest := 0
+	for i, f := range floats {
+		if math.Abs(f-t) < math.Abs(floats[nearest]-t) {
+			nearest = i
+		}
+	}
+	return nearest, nil
+}
+
+// Returns f converted to T. T needs to be one of the integer, float or complex types.
+func fromF64[T Value[any]](f float64) T {
+	var out T
+	var v any
+	switch any(out).(type) {
+	case Int:
+		v = Int(f)
+	case I8:
+		v = I8(f)
+	case I16:
+		v = I16(f)
+	case I32:
+		v = I32(f)
+	case I64:
+		v = I64(f)
+	case Uint:
+		v = Uint(f)
+	case U8:
+		v = U8(f)
+	case U16:
+		v = U16(f)
+	case U32:
+		v = U32(f)
+	case U64:
+		v = U64(f)
+	case Byte:
+		v = Byte(f)
+	case F32:
+		v = F32(f)
+	case F64:
+		v = F64(f)
+	case C64:
+		v = C64(complex(f, 0))
+	case C128:
+		v = C128(complex(f, 0))
+	default:
+		return out
+	}
+	return v.(T)
+}
+
+// # Convolve
+//
+// Return the discrete convolution of the slice and kernel, which has length sl.Len()+kernel.Len()-1.
+//
+//	[1,2,3]Convolve([0,1,0.5]) return [0,1,2.5,4,1.5]
+//
+// Returns ErrNotNumeric if T does not implement Num.
+// The values are multiplied and added in T, so integer types wrap around on overflow like the * and + operators do.
+func (sl Slice[T]) Convolve(kernel Slice[T]) (Slice[T], error) {
+	if _, ok := any(sl.Default()).(Num[T]); !ok {
+		return New[T](), ErrNotNumeric
+	}
+	if sl.IsEmpty() || kernel.IsEmpty() {
+		return New[T](), ErrIsEmpty
+	}
+
+	convolved := make(Slice[T], sl.Len()+kernel.Len()-1)
+	for i, a := range sl {
+		for j, b := range kernel {
+			product := any(a).(Num[T]).Mul(b)
+			convolved[i+j] = any(convolved[i+j]).(Num[T]).Add(product)
+		}
+	}
+	return convolved, nil
+}
+
+// # PairwiseDiffs
+//
+// Return the absolute difference of every unordered pair of values, which is n(n-1)/2 values.
+//
+//	[1,4,6]PairwiseDiffs() return [3,5,2]
+func (sl Slice[T]) PairwiseDiffs() (Slice[F64], error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return New[F64](), err
+	}
+	n := len(floats)
+	diffs := make(Slice[F64], 0, n*(n-1)/2)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			diffs.Push(F64(math.Abs(floats[i] - floats[j])))
+		}
+	}
+	return diffs, nil
+}
+
+// # DiffN
+//
+// Return the n-th discrete difference, where the difference of each pair of adjacent values is taken n times.
+// The result is n values shorter than the slice.
+//
+//	[1,4,9,16]DiffN(1) return [3,5,7]
+//	[1,4,9,16]DiffN(2) return [2,2]
+//
+// Returns ErrNotNumeric if T does not implement Num and ErrTooShort if n is positive and not smaller than the length of the slice.
+// DiffN(0) returns a copy of the slice, even if it is empty. The differences are computed in T, so unsigned types wrap around like the - operator does.
+func (sl Slice[T]) DiffN(n uint) (Slice[T], error) {
+	if _, ok := any(sl.Default()).(Num[T]); !ok {
+		return New[T](), ErrNotNumeric
+	}
+	if n > 0 && int(n) >= sl.Len() {
+		return New[T](), ErrTooShort
+	}
+	diffs := make(Slice[T], sl.Len())
+	copy(diffs, sl)
+	for ; n > 0; n-- {
+		for i := 0; i < diffs.Len()-1; i++ {
+			diffs[i] = any(diffs[i+1]).(Num[T]).Sub(diffs[i])
+		}
+		diffs = diffs[:diffs.Len()-1]
+	}
+	return diffs, nil
+}
+
+// Returns a sorted copy of floats.
+func sortedCopy(floats []float64) []float64 {
+	sorted := make([]float64, len(floats))
+	copy(sorted, floats)
+	sort.Float64s(sorted)
+	return sorted
+}
+
+// Returns the p-th percentile of sorted, interpolating linearly between the closest ranks.
+// sorted cannot be empty and p needs to be between 0 and 100.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	low := int(math.Floor(rank))
+	if low == len(sorted)-1 {
+		return sorted[low]
+	}
+	return sorted[low] + (rank-float64(low))*(sorted[low+1]-sorted[low])
+}
+
+// # Outliers
+//
+// Return all values outside of [Q1 - 1.5*IQR, Q3 + 1.5*IQR], where Q1 and Q3 are the 25th and 75th
+// percentiles and IQR is the distance between them.
+//
+//	[1,2,3,4,5,100]Outliers() return [100]
+//
+// Returns ErrTooShort if the slice has less than 4 values.
+func (sl Slice[T]) Outliers() (Slice[T], error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return New[T](), err
+	}
+	if len(floats) < 4 {
+		return New[T](), ErrTooShort
+	}
+	sorted := sortedCopy(floats)
+	q1, q3 := percentile(sorted, 25), percentile(sorted, 75)
+	iqr := q3 - q1
+	low, high := q1-1.5*iqr, q3+1.5*iqr
+
+	outliers := New[T]()
+	for i, f := range floats {
+		if f < low || f > high {
+			outliers.Push(sl[i])
+		}
+	}
+	return outliers, nil
+}
+
+// # Percentile
+//
+// Return the p-th percentile of the slice, interpolating linearly between the closest ranks.
+// The slice is not modified.
+//
+//	[1,2,3,4]Percentile(50) return 2.5
+//	[1,2,3,4]Percentile(100) return 4
+//
+// Returns ErrInvalidArgument if p is not between 0 and 100 (including NaN).
+func (sl Slice[T]) Percentile(p F64) (F64, error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return 0, err
+	}
+	if sl.IsEmpty() {
+		return 0, ErrIsEmpty
+	}
+	if !(p >= 0 && p <= 100) {
+		return 0, ErrInvalidArgument
+	}
+	return F64(percentile(sortedCopy(floats), float64(p))), nil
+}
+
+// # Median
+//
+// Return the middle value of a sorted copy of the slice, or the average of the two middle values if the length is even.
+// The slice itself is not modified.
+//
+//	[3,1,2]Median() return 2
+//	[4,1,3,2]Median() return 2.5
+//
+// Returns ErrIsEmpty if the slice is empty.
+func (sl Slice[T]) Median() (F64, error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return 0, err
+	}
+	if sl.IsEmpty() {
+		return 0, ErrIsEmpty
+	}
+	sorted := sortedCopy(floats)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return F64(sorted[mid]), nil
+	}
+	return F64((sorted[mid-1] + sorted[mid]) / 2), nil
+}
+
+// # Span
+//
+// Return the difference between the largest and the smallest value.
+//
+//	[3,9,1,4]Span() return 8
+//
+// Returns ErrIsEmpty if the slice is empty and ErrNotNumeric if T is not one of the integer or float types.
+// The difference is computed in T, so the span of a signed type wraps around if it does not fit in T.
+func (sl Slice[T]) Span() (T, error) {
+	switch any(sl.Default()).(type) {
+	case Int, I8, I16, I32, I64, Uint, U8, U16, U32, U64, Byte, F32, F64:
+	default:
+		return sl.Default(), ErrNotNumeric
+	}
+	high, err := sl.Max()
+	if err != nil {
+		return sl.Default(), err
+	}
+	low, _ := sl.Min()
+	return any(high).(Num[T]).Sub(low), nil
+}
+
+// # AllClose
+//
+// Returns true if every pair of values a and b at the same index satisfies |a - b| <= absTol + relTol*|b|.
+// NaN is never close to anything, including another NaN. Infinities are only close to the same infinity.
+//
+//	[1,2]AllClose([1.001,2], 0.01, 0) return true
+//	[1,2]AllClose([1.1,2], 0.01, 0) return false
+func (sl Slice[T]) AllClose(other Slice[T], relTol, absTol F64) (bool, error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return false, err
+	}
+	if sl.Len() != other.Len() {
+		return false, ErrLengthMismatch
+	}
+	otherFloats, _ := other.floats()
+	for i, a := range floats {
+		b := otherFloats[i]
+		if a == b {
+			continue
+		}
+		if math.IsInf(a, 0) || math.IsInf(b, 0) || !(math.Abs(a-b) <= float64(absTol)+float64(relTol)*math.Abs(b)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// # RunningStats
+//
+// Accumulates the count, mean and variance of numeric values one at a time, using Welford's online algorithm.
+// The zero value is ready to use.
+type RunningStats[T Value[any]] struct {
+	count int
+	mean  float64
+	m2    float64
+}
+
+// # Push
+//
+// Add value v to the statistics. Returns ErrNotNumeric if T is not one of the integer or float types.
+func (rs *RunningStats[T]) Push(v T) error {
+	f, ok := toF64(v)
+	if !ok {
+		return ErrNotNumeric
+	}
+	rs.count++
+	delta := f - rs.mean
+	rs.mean += delta / float64(rs.count)
+	rs.m2 += delta * (f - rs.mean)
+	return nil
+}
+
+// # Count
+//
+// Return the amount of values pushed so far.
+func (rs *RunningStats[T]) Count() int {
+	return rs.count
+}
+
+// # Mean
+//
+// Return the mean of the values pushed so far, or 0 if no values have been pushed.
+func (rs *RunningStats[T]) Mean() F64 {
+	return F64(rs.mean)
+}
+
+// # Variance
+//
+// Return the population variance of the values pushed so far, or 0 if no values have been pushed.
+func (rs *RunningStats[T]) Variance() F64 {
+	if rs.count == 0 {
+		return 0
+	}
+	return F64(rs.m2 / float64(rs.count))
+}
+
+// # RunningStats
+//
+// Return a RunningStats with all the values of the slice pushed to it.
+//
+//	[2,4,6]RunningStats() return stats with Count() 3, Mean() 4 and Variance() 2.67
+func (sl Slice[T]) RunningStats() (*RunningStats[T], error) {
+	rs := &RunningStats[T]{}
+	if _, ok := toF64(sl.Default()); !ok {
+		return rs, ErrNotNumeric
+	}
+	for _, v := range sl {
+		rs.Push(v)
+	}
+	return rs, nil
+}
+
+// # IsPermutationOfRange
+//
+// Returns true if the slice contains every integer from start up to start+sl.Len()-1 exactly once, in any order.
+// Always returns false if T is not one of the integer types.
+//
+//	[2,0,1]IsPermutationOfRange(0) return true
+//	[2,0,0]IsPermutationOfRange(0) return false
+func (sl Slice[T]) IsPermutationOfRange(start int) bool {
+	if _, ok := toInt64(sl.Default()); !ok {
+		return false
+	}
+	seen := make([]bool, sl.Len())
+	for _, v := range sl {
+		i, ok := toInt64(v)
+		if !ok {
+			return false
+		}
+		offset := i - int64(start)
+		if offset < 0 || offset >= int64(sl.Len()) || seen[offset] {
+			return false
+		}
+		seen[offset] = true
+	}
+	return true
+}
+
+// # Gradient
+//
+// Return the gradient of the slice, using central differences (sl[i+1]-sl[i-1])/2 for interior values
+// and forward and backward differences for the first and the last value.
+//
+//	[1,4,9,16]Gradient() return [3,4,6,7]
+//
+// Returns ErrTooShort if the slice has less than 2 values.
+func (sl Slice[T]) Gradient() (Slice[F64], error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return New[F64](), err
+	}
+	n := len(floats)
+	if n < 2 {
+		return New[F64](), ErrTooShort
+	}
+	gradient := make(Slice[F64], n)
+	gradient[0] = F64(floats[1] - floats[0])
+	for i := 1; i < n-1; i++ {
+		gradient[i] = F64((floats[i+1] - floats[i-1]) / 2)
+	}
+	gradient[n-1] = F64(floats[n-1] - floats[n-2])
+	return gradient, nil
+}
+
+// # CumProduct
+//
+// Return a slice with the product of all values up to and including each index.
+//
+//	[1,2,3,4]CumProduct() return [1,2,6,24]
+//
+// Returns ErrNotNumeric if T does not implement Num.
+// The values are multiplied in T, so integer types wrap around on overflow like the * operator does.
+func (sl Slice[T]) CumProduct() (Slice[T], error) {
+	if _, ok := any(sl.Default()).(Num[T]); !ok {
+		return New[T](), ErrNotNumeric
+	}
+	cum := make(Slice[T], sl.Len())
+	for i, v := range sl {
+		if i == 0 {
+			cum[i] = v
+			continue
+		}
+		cum[i] = any(cum[i-1]).(Num[T]).Mul(v)
+	}
+	return cum, nil
+}
+
+// # Digitize
+//
+// Return the index of the bucket each value falls into, given the sorted bucket edges.
+// A value v falls into bucket i if edges[i-1] <= v < edges[i], so values below the first edge are in bucket 0
+// and values not below the last edge are in bucket edges.Len().
+//
+//	[0,1,2.5,4]Digitize([1,2,3]) return [0,1,2,3]
+//
+// Returns ErrNotSorted if edges is not sorted in increasing order.
+func (sl Slice[T]) Digitize(edges Slice[T]) (Slice[Int], error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return New[Int](), err
+	}
+	edgeFloats, _ := edges.floats()
+	if !sort.Float64sAreSorted(edgeFloats) {
+		return New[Int](), ErrNotSorted
+	}
+	buckets := make(Slice[Int], len(floats))
+	for i, f := range floats {
+		buckets[i] = Int(sort.Search(len(edgeFloats), func(j int) bool {
+			return edgeFloats[j] > f
+		}))
+	}
+	return buckets, nil
+}
+
+// # CosineSimilarity
+//
+// Return the cosine of the angle between the slice and other, treated as vectors.
+//
+//	[1,0]CosineSimilarity([2,0]) return 1
+//	[1,0]CosineSimilarity([0,1]) return 0
+//	[1,0]CosineSimilarity([-1,0]) return -1
+//
+// Returns ErrInvalidArgument if either vector has a magnitude of 0.
+func (sl Slice[T]) CosineSimilarity(other Slice[T]) (F64, error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return 0, err
+	}
+	if sl.Len() != other.Len() {
+		return 0, ErrLengthMismatch
+	}
+	otherFloats, _ := other.floats()
+
+	var dot, norm, otherNorm float64
+	for i, a := range floats {
+		b := otherFloats[i]
+		dot += a * b
+		norm += a * a
+		otherNorm += b * b
+	}
+	if norm == 0 || otherNorm == 0 {
+		return 0, ErrInvalidArgument
+	}
+	return F64(dot / (math.Sqrt(norm) * math.Sqrt(otherNorm))), nil
+}
+
+// # QuantileBins
+//
+// Assign every value to one of n bins that hold roughly the same amount of values, and return the bin index of each value.
+// The bin edges are the percentiles 100*k/n of the slice, and each bin includes its upper edge.
+//
+//	[1,2,3,4,5,6]QuantileBins(3) return [0,0,1,1,2,2]
+//	[1,2,3,4,5,6]QuantileBins(2) return [0,0,0,1,1,1]
+//
+// Returns ErrInvalidArgument if n is 0, ErrIsEmpty if the slice is empty and
+// ErrTooShort if the slice has too few distinct values to get n bins with different edges.
+func (sl Slice[T]) QuantileBins(n uint) (Slice[Int], error) {
+	floats, err := sl.floats()
+	if err != nil {
+		return New[Int](), err
+	}
+	if n == 0 {
+		return New[Int](), ErrInvalidArgument
+	}
+	if sl.IsEmpty() {
+		return New[Int](), ErrIsEmpty
+	}
+	sorted := sortedCopy(floats)
+	edges := make([]float64, n+1)
+	for k := range edges {
+		edges[k] = percentile(sorted, 100*float64(k)/float64(n))
+		if k > 0 && edges[k] <= edges[k-1] {
+			return New[Int](), ErrTooShort
+		}
+	}
+
+	// Only the inner edges decide the bin, the outer ones are the minimum and maximum
+	inner := edges[1:n]
+	bins := make(Slice[Int], len(floats))
+	for i, f := range floats {
+		bins[i] = Int(sort.SearchFloat64s(inner, f))
+	}
+	return bins, nil
+}
@@ -0,0 +1,498 @@
+package sliceutils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNearestIndex(t *testing.T) {
+	tests := []struct {
+		sl     Slice[F64]
+		target F64
+		want   int
+		err    error
+	}{
+		{Slice[F64]{1, 5, 9}, 6, 1, nil},
+		{Slice[F64]{1, 5, 9}, 9, 2, nil},
+		{Slice[F64]{1, 5, 9}, 3, 0, nil},
+		{Slice[F64]{1, 5, 9}, 100, 2, nil},
+		{Slice[F64]{}, 1, -1, ErrIsEmpty},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.NearestIndex(tt.target); got != tt.want || err != tt.err {
+			t.Errorf("%v.NearestIndex(%v) = %v, %v, want %v, %v", tt.sl, tt.target, got, err, tt.want, tt.err)
+		}
+	}
+
+	if _, err := (Slice[Str]{"a"}).NearestIndex("b"); err != ErrNotNumeric {
+		t.Errorf("NearestIndex() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestConvolve(t *testing.T) {
+	floats := Slice[F64]{1, 2, 3}
+	if got, err := floats.Convolve(Slice[F64]{0, 1, 0.5}); err != nil || !got.Eq(Slice[F64]{0, 1, 2.5, 4, 1.5}) {
+		t.Errorf("%v.Convolve([0, 1, 0.5]) = %v, %v, want [0, 1, 2.5, 4, 1.5]", floats, got, err)
+	}
+
+	ints := Slice[Int]{1, 2, 3}
+	if got, err := ints.Convolve(Slice[Int]{1, 1}); err != nil || !got.Eq(Slice[Int]{1, 3, 5, 3}) {
+		t.Errorf("%v.Convolve([1, 1]) = %v, %v, want [1, 3, 5, 3]", ints, got, err)
+	}
+
+	// Large integers are not rounded to the nearest float64
+	large := Slice[I64]{1<<53 + 1}
+	if got, err := large.Convolve(Slice[I64]{1, 2}); err != nil || !got.Eq(Slice[I64]{1<<53 + 1, 1<<54 + 2}) {
+		t.Errorf("%v.Convolve([1, 2]) = %v, %v, want [%v, %v]", large, got, err, 1<<53+1, 1<<54+2)
+	}
+
+	if _, err := ints.Convolve(Slice[Int]{}); err != ErrIsEmpty {
+		t.Errorf("Convolve([]) returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a"}).Convolve(Slice[Str]{"b"}); err != ErrNotNumeric {
+		t.Errorf("Convolve() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestPairwiseDiffs(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[F64]
+	}{
+		{Slice[Int]{1, 4, 6}, Slice[F64]{3, 5, 2}},
+		{Slice[Int]{5, 1, 1, 2}, Slice[F64]{4, 4, 3, 0, 1, 1}},
+		{Slice[Int]{7}, Slice[F64]{}},
+		{Slice[Int]{}, Slice[F64]{}},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.PairwiseDiffs(); err != nil || !got.Eq(tt.want) {
+			t.Errorf("%v.PairwiseDiffs() = %v, %v, want %v", tt.sl, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[Str]{"a", "b"}).PairwiseDiffs(); err != ErrNotNumeric {
+		t.Errorf("PairwiseDiffs() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestOutliers(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+		err  error
+	}{
+		{Slice[Int]{1, 2, 3, 4, 5, 100}, Slice[Int]{100}, nil},
+		{Slice[Int]{-100, 1, 2, 3, 4, 5}, Slice[Int]{-100}, nil},
+		{Slice[Int]{1, 2, 3, 4, 5, 6}, Slice[Int]{}, nil},
+		{Slice[Int]{1, 2, 100}, Slice[Int]{}, ErrTooShort},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.Outliers(); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.Outliers() = %v, %v, want %v, %v", tt.sl, got, err, tt.want, tt.err)
+		}
+	}
+
+	if _, err := (Slice[Str]{"a", "b", "c", "d"}).Outliers(); err != ErrNotNumeric {
+		t.Errorf("Outliers() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sl := Slice[Int]{4, 1, 3, 2}
+	tests := []struct {
+		p    F64
+		want F64
+		err  error
+	}{
+		{0, 1, nil},
+		{50, 2.5, nil},
+		{100, 4, nil},
+		{25, 1.75, nil},
+		{-1, 0, ErrInvalidArgument},
+		{101, 0, ErrInvalidArgument},
+		{F64(math.NaN()), 0, ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		if got, err := sl.Percentile(tt.p); got != tt.want || err != tt.err {
+			t.Errorf("%v.Percentile(%v) = %v, %v, want %v, %v", sl, tt.p, got, err, tt.want, tt.err)
+		}
+	}
+	if !sl.Eq(Slice[Int]{4, 1, 3, 2}) {
+		t.Errorf("Percentile() modified the slice to %v", sl)
+	}
+
+	if _, err := (Slice[Int]{}).Percentile(50); err != ErrIsEmpty {
+		t.Errorf("Percentile() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a"}).Percentile(50); err != ErrNotNumeric {
+		t.Errorf("Percentile() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestAllClose(t *testing.T) {
+	nan, inf := F64(math.NaN()), F64(math.Inf(1))
+	tests := []struct {
+		name           string
+		sl, other      Slice[F64]
+		relTol, absTol F64
+		want           bool
+	}{
+		{"equal", Slice[F64]{1, 2}, Slice[F64]{1, 2}, 0, 0, true},
+		{"within relative tolerance", Slice[F64]{1, 100}, Slice[F64]{1, 100.9}, 0.01, 0, true},
+		{"outside relative tolerance", Slice[F64]{1, 100}, Slice[F64]{1, 101.1}, 0.01, 0, false},
+		{"within absolute tolerance", Slice[F64]{0.05}, Slice[F64]{0}, 0, 0.1, true},
+		{"outside absolute tolerance", Slice[F64]{0.15}, Slice[F64]{0}, 0, 0.1, false},
+		{"combined tolerance", Slice[F64]{10.14}, Slice[F64]{10}, 0.01, 0.05, true},
+		{"NaN", Slice[F64]{nan}, Slice[F64]{nan}, 1, 1, false},
+		{"same infinity", Slice[F64]{inf}, Slice[F64]{inf}, 0, 0, true},
+		{"infinity and a large value", Slice[F64]{inf}, Slice[F64]{1e308}, 1, 1, false},
+		{"empty", Slice[F64]{}, Slice[F64]{}, 0, 0, true},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.AllClose(tt.other, tt.relTol, tt.absTol); got != tt.want || err != nil {
+			t.Errorf("%s: %v.AllClose(%v, %v, %v) = %v, %v, want %v", tt.name, tt.sl, tt.other, tt.relTol, tt.absTol, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[F64]{1}).AllClose(Slice[F64]{1, 2}, 0, 0); err != ErrLengthMismatch {
+		t.Errorf("AllClose() with different lengths returned %v, want %v", err, ErrLengthMismatch)
+	}
+	if _, err := (Slice[Str]{"a"}).AllClose(Slice[Str]{"a"}, 0, 0); err != ErrNotNumeric {
+		t.Errorf("AllClose() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestRunningStats(t *testing.T) {
+	sl := Slice[F64]{2, 4, 4, 4, 5, 5, 7, 9}
+
+	// Batch mean and population variance to compare the online results against
+	var mean, variance F64
+	for _, v := range sl {
+		mean += v
+	}
+	mean /= F64(sl.Len())
+	for _, v := range sl {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= F64(sl.Len())
+
+	rs, err := sl.RunningStats()
+	if err != nil || rs.Count() != sl.Len() {
+		t.Fatalf("RunningStats() = %v, %v", rs, err)
+	}
+	if math.Abs(float64(rs.Mean()-mean)) > 1e-12 || math.Abs(float64(rs.Variance()-variance)) > 1e-12 {
+		t.Errorf("RunningStats() has mean %v and variance %v, want %v and %v", rs.Mean(), rs.Variance(), mean, variance)
+	}
+
+	var online RunningStats[Int]
+	if online.Mean() != 0 || online.Variance() != 0 || online.Count() != 0 {
+		t.Errorf("zero RunningStats has count %v, mean %v and variance %v, want 0", online.Count(), online.Mean(), online.Variance())
+	}
+	for _, v := range []Int{1, 2, 3} {
+		online.Push(v)
+	}
+	if online.Mean() != 2 || math.Abs(float64(online.Variance())-2.0/3) > 1e-12 {
+		t.Errorf("RunningStats after pushing [1, 2, 3] has mean %v and variance %v, want 2 and 2/3", online.Mean(), online.Variance())
+	}
+
+	if _, err := (Slice[Str]{"a"}).RunningStats(); err != ErrNotNumeric {
+		t.Errorf("RunningStats() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestIsPermutationOfRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		sl    Slice[Int]
+		start int
+		want  bool
+	}{
+		{"valid", Slice[Int]{2, 0, 1}, 0, true},
+		{"valid with offset", Slice[Int]{3, 5, 4}, 3, true},
+		{"duplicate", Slice[Int]{2, 0, 0}, 0, false},
+		{"gap", Slice[Int]{0, 1, 3}, 0, false},
+		{"wrong start", Slice[Int]{1, 2, 3}, 0, false},
+		{"empty", Slice[Int]{}, 0, true},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.IsPermutationOfRange(tt.start); got != tt.want {
+			t.Errorf("%s: %v.IsPermutationOfRange(%v) = %v, want %v", tt.name, tt.sl, tt.start, got, tt.want)
+		}
+	}
+
+	if (Slice[F64]{0, 1}).IsPermutationOfRange(0) {
+		t.Errorf("IsPermutationOfRange() on F64 = true, want false")
+	}
+}
+
+func TestGradient(t *testing.T) {
+	tests := []struct {
+		name string
+		sl   Slice[Int]
+		want Slice[F64]
+	}{
+		{"linear", Slice[Int]{1, 3, 5, 7}, Slice[F64]{2, 2, 2, 2}},
+		{"quadratic", Slice[Int]{1, 4, 9, 16}, Slice[F64]{3, 4, 6, 7}},
+		{"two values", Slice[Int]{1, 2}, Slice[F64]{1, 1}},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.Gradient(); err != nil || !got.Eq(tt.want) {
+			t.Errorf("%s: %v.Gradient() = %v, %v, want %v", tt.name, tt.sl, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[Int]{1}).Gradient(); err != ErrTooShort {
+		t.Errorf("Gradient() on a single value returned %v, want %v", err, ErrTooShort)
+	}
+	if _, err := (Slice[Str]{"a", "b"}).Gradient(); err != ErrNotNumeric {
+		t.Errorf("Gradient() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestCumProduct(t *testing.T) {
+	ints := Slice[Int]{1, 2, 0, 4}
+	if got, err := ints.CumProduct(); err != nil || !got.Eq(Slice[Int]{1, 2, 0, 0}) {
+		t.Errorf("%v.CumProduct() = %v, %v, want [1, 2, 0, 0]", ints, got, err)
+	}
+
+	floats := Slice[F64]{0.5, 4, 0, 3}
+	if got, err := floats.CumProduct(); err != nil || !got.Eq(Slice[F64]{0.5, 2, 0, 0}) {
+		t.Errorf("%v.CumProduct() = %v, %v, want [0.5, 2, 0, 0]", floats, got, err)
+	}
+
+	// Large integers are not rounded to the nearest float64
+	large := Slice[I64]{1<<53 + 1, 3}
+	if got, err := large.CumProduct(); err != nil || !got.Eq(Slice[I64]{1<<53 + 1, 3<<53 + 3}) {
+		t.Errorf("%v.CumProduct() = %v, %v, want [%v, %v]", large, got, err, 1<<53+1, 3<<53+3)
+	}
+
+	// Integer types wrap around like the * operator does
+	bytes := Slice[U8]{16, 17}
+	if got, err := bytes.CumProduct(); err != nil || !got.Eq(Slice[U8]{16, 16}) {
+		t.Errorf("%v.CumProduct() = %v, %v, want [16, 16]", bytes, got, err)
+	}
+
+	if got, err := (Slice[Int]{}).CumProduct(); err != nil || !got.IsEmpty() {
+		t.Errorf("CumProduct() on empty slice = %v, %v, want []", got, err)
+	}
+	if _, err := (Slice[Str]{"a"}).CumProduct(); err != ErrNotNumeric {
+		t.Errorf("CumProduct() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+
+	if got := large.CumulativeProduct(); !got.Eq(Slice[I64]{1<<53 + 1, 3<<53 + 3}) {
+		t.Errorf("%v.CumulativeProduct() = %v, want the same as CumProduct", large, got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("CumulativeProduct() on Str did not panic")
+		}
+	}()
+	(Slice[Str]{"a"}).CumulativeProduct()
+}
+
+func TestDigitize(t *testing.T) {
+	tests := []struct {
+		sl, edges Slice[F64]
+		want      Slice[Int]
+		err       error
+	}{
+		{Slice[F64]{0, 1, 2.5, 4}, Slice[F64]{1, 2, 3}, Slice[Int]{0, 1, 2, 3}, nil},
+		{Slice[F64]{2, 1.999, 3}, Slice[F64]{1, 2, 3}, Slice[Int]{2, 1, 3}, nil},
+		{Slice[F64]{-5, 5}, Slice[F64]{}, Slice[Int]{0, 0}, nil},
+		{Slice[F64]{}, Slice[F64]{1}, Slice[Int]{}, nil},
+		{Slice[F64]{1}, Slice[F64]{3, 1}, Slice[Int]{}, ErrNotSorted},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.Digitize(tt.edges); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.Digitize(%v) = %v, %v, want %v, %v", tt.sl, tt.edges, got, err, tt.want, tt.err)
+		}
+	}
+
+	if _, err := (Slice[Str]{"a"}).Digitize(Slice[Str]{"b"}); err != ErrNotNumeric {
+		t.Errorf("Digitize() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[F64]
+		want      F64
+	}{
+		{Slice[F64]{1, 0}, Slice[F64]{2, 0}, 1},
+		{Slice[F64]{1, 0}, Slice[F64]{0, 1}, 0},
+		{Slice[F64]{1, 0}, Slice[F64]{-1, 0}, -1},
+		{Slice[F64]{1, 1}, Slice[F64]{1, 0}, F64(math.Sqrt(0.5))},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.CosineSimilarity(tt.other); math.Abs(float64(got-tt.want)) > 1e-12 || err != nil {
+			t.Errorf("%v.CosineSimilarity(%v) = %v, %v, want %v", tt.sl, tt.other, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[F64]{0, 0}).CosineSimilarity(Slice[F64]{1, 0}); err != ErrInvalidArgument {
+		t.Errorf("CosineSimilarity() with a zero vector returned %v, want %v", err, ErrInvalidArgument)
+	}
+	if _, err := (Slice[F64]{1}).CosineSimilarity(Slice[F64]{1, 0}); err != ErrLengthMismatch {
+		t.Errorf("CosineSimilarity() with different lengths returned %v, want %v", err, ErrLengthMismatch)
+	}
+	if _, err := (Slice[Str]{"a"}).CosineSimilarity(Slice[Str]{"b"}); err != ErrNotNumeric {
+		t.Errorf("CosineSimilarity() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestQuantileBins(t *testing.T) {
+	sl := Slice[Int]{6, 1, 4, 2, 5, 3}
+	tests := []struct {
+		n    uint
+		want Slice[Int]
+		err  error
+	}{
+		{1, Slice[Int]{0, 0, 0, 0, 0, 0}, nil},
+		{2, Slice[Int]{1, 0, 1, 0, 1, 0}, nil},
+		{3, Slice[Int]{2, 0, 1, 0, 2, 1}, nil},
+		{0, Slice[Int]{}, ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		if got, err := sl.QuantileBins(tt.n); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.QuantileBins(%v) = %v, %v, want %v, %v", sl, tt.n, got, err, tt.want, tt.err)
+		}
+	}
+
+	// Every bin gets about the same amount of values
+	large := make(Slice[Int], 100)
+	for i := range large {
+		large[i] = Int(i * 7 % 100)
+	}
+	bins, err := large.QuantileBins(4)
+	if err != nil {
+		t.Fatalf("QuantileBins(4) returned %v", err)
+	}
+	for bin, count := range bins.CountByMany(
+		func(b Int) bool { return b == 0 },
+		func(b Int) bool { return b == 1 },
+		func(b Int) bool { return b == 2 },
+		func(b Int) bool { return b == 3 },
+	) {
+		if count < 24 || count > 26 {
+			t.Errorf("bin %v has %v values, want about 25", bin, count)
+		}
+	}
+
+	if _, err := (Slice[Int]{1, 1, 1, 2}).QuantileBins(4); err != ErrTooShort {
+		t.Errorf("QuantileBins() with too few distinct values returned %v, want %v", err, ErrTooShort)
+	}
+	if _, err := (Slice[Int]{}).QuantileBins(2); err != ErrIsEmpty {
+		t.Errorf("QuantileBins() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a"}).QuantileBins(2); err != ErrNotNumeric {
+		t.Errorf("QuantileBins() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want F64
+	}{
+		{Slice[Int]{3, 1, 2}, 2},
+		{Slice[Int]{4, 1, 3, 2}, 2.5},
+		{Slice[Int]{7}, 7},
+		{Slice[Int]{5, 5, 1, 9}, 5},
+		{Slice[Int]{-3, -1}, -2},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.Median(); got != tt.want || err != nil {
+			t.Errorf("%v.Median() = %v, %v, want %v", tt.sl, got, err, tt.want)
+		}
+	}
+
+	sl := Slice[F64]{3, 1, 2}
+	if sl.Median(); !sl.Eq(Slice[F64]{3, 1, 2}) {
+		t.Errorf("Median() modified the slice to %v", sl)
+	}
+	if _, err := (Slice[Int]{}).Median(); err != ErrIsEmpty {
+		t.Errorf("Median() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a"}).Median(); err != ErrNotNumeric {
+		t.Errorf("Median() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestDiffN(t *testing.T) {
+	quadratic := Slice[Int]{1, 4, 9, 16, 25}
+	tests := []struct {
+		sl   Slice[Int]
+		n    uint
+		want Slice[Int]
+		err  error
+	}{
+		{quadratic, 0, Slice[Int]{1, 4, 9, 16, 25}, nil},
+		{quadratic, 1, Slice[Int]{3, 5, 7, 9}, nil},
+		// The second difference of a quadratic is constant
+		{quadratic, 2, Slice[Int]{2, 2, 2}, nil},
+		{quadratic, 3, Slice[Int]{0, 0}, nil},
+		{quadratic, 5, Slice[Int]{}, ErrTooShort},
+		{Slice[Int]{}, 0, Slice[Int]{}, nil},
+		{Slice[Int]{}, 1, Slice[Int]{}, ErrTooShort},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.DiffN(tt.n); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.DiffN(%v) = %v, %v, want %v, %v", tt.sl, tt.n, got, err, tt.want, tt.err)
+		}
+	}
+	if !quadratic.Eq(Slice[Int]{1, 4, 9, 16, 25}) {
+		t.Errorf("DiffN() modified the slice to %v", quadratic)
+	}
+
+	// Unsigned types wrap around like the - operator does
+	bytes := Slice[U8]{5, 3, 4}
+	if got, err := bytes.DiffN(1); err != nil || !got.Eq(Slice[U8]{254, 1}) {
+		t.Errorf("%v.DiffN(1) = %v, %v, want [254, 1]", bytes, got, err)
+	}
+	// Large integers are not rounded to the nearest float64
+	large := Slice[I64]{1 << 53, 1<<53 + 1}
+	if got, err := large.DiffN(1); err != nil || !got.Eq(Slice[I64]{1}) {
+		t.Errorf("%v.DiffN(1) = %v, %v, want [1]", large, got, err)
+	}
+	floats := Slice[F64]{0.5, 2, 1}
+	if got, err := floats.DiffN(1); err != nil || !got.Eq(Slice[F64]{1.5, -1}) {
+		t.Errorf("%v.DiffN(1) = %v, %v, want [1.5, -1]", floats, got, err)
+	}
+
+	if _, err := (Slice[Str]{"a", "b"}).DiffN(1); err != ErrNotNumeric {
+		t.Errorf("DiffN() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	if got, err := (Slice[Int]{3, 9, 1, 4}).Span(); got != 8 || err != nil {
+		t.Errorf("[3, 9, 1, 4].Span() = %v, %v, want 8", got, err)
+	}
+	if got, err := (Slice[F64]{-1.5, 2, 0.25}).Span(); got != 3.5 || err != nil {
+		t.Errorf("[-1.5, 2, 0.25].Span() = %v, %v, want 3.5", got, err)
+	}
+	if got, err := (Slice[U8]{7}).Span(); got != 0 || err != nil {
+		t.Errorf("[7].Span() = %v, %v, want 0", got, err)
+	}
+
+	// Large integers are not rounded to the nearest float64
+	if got, err := (Slice[I64]{1 << 53, 1<<53 + 1}).Span(); got != 1 || err != nil {
+		t.Errorf("[%v, %v].Span() = %v, %v, want 1", 1<<53, 1<<53+1, got, err)
+	}
+	if got, err := (Slice[Int]{0, math.MaxInt64}).Span(); got != math.MaxInt64 || err != nil {
+		t.Errorf("[0, %v].Span() = %v, %v, want %v", math.MaxInt64, got, err, math.MaxInt64)
+	}
+	if got, err := (Slice[U64]{math.MaxUint64, 1}).Span(); got != math.MaxUint64-1 || err != nil {
+		t.Errorf("[%v, 1].Span() = %v, %v, want %v", uint64(math.MaxUint64), got, err, uint64(math.MaxUint64-1))
+	}
+
+	if _, err := (Slice[Int]{}).Span(); err != ErrIsEmpty {
+		t.Errorf("Span() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Str]{"a", "b"}).Span(); err != ErrNotNumeric {
+		t.Errorf("Span() on Str returned %v, want %v", err, ErrNotNumeric)
+	}
+	if _, err := (Slice[C128]{1, 2}).Span(); err != ErrNotNumeric {
+		t.Errorf("Span() on C128 returned %v, want %v", err, ErrNotNumeric)
+	}
+}
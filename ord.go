@@ -104,6 +104,34 @@ func (sl Slice[T]) Le(other any) bool {
 	}
 }
 
+// # CanonicalRotation
+//
+// Return a new slice with the lexicographically smallest rotation of the slice.
+//
+//	[3,1,2]CanonicalRotation() return [1,2,3]
+//	[2,1,2,1,1]CanonicalRotation() return [1,1,2,1,2]
+func (sl Slice[T]) CanonicalRotation() Slice[T] {
+	n := sl.Len()
+	best := 0
+	for start := 1; start < n; start++ {
+		for i := 0; i < n; i++ {
+			v, b := sl[(start+i)%n], sl[(best+i)%n]
+			if v.Lt(b) {
+				best = start
+				break
+			}
+			if v.Gt(b) {
+				break
+			}
+		}
+	}
+
+	rotation := make(Slice[T], 0, n)
+	rotation.Push(sl[best:]...)
+	rotation.Push(sl[:best]...)
+	return rotation
+}
+
 // Gt and Lt implementations for all builtin types
 
 func (v Int) Gt(v2 any) bool {
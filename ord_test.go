@@ -0,0 +1,32 @@
+package sliceutils
+
+import "testing"
+
+func TestCanonicalRotation(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{3, 1, 2}, Slice[Int]{1, 2, 3}},
+		{Slice[Int]{2, 1, 2, 1, 1}, Slice[Int]{1, 1, 2, 1, 2}},
+		{Slice[Int]{1, 2, 1, 2}, Slice[Int]{1, 2, 1, 2}},
+		{Slice[Int]{5}, Slice[Int]{5}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.CanonicalRotation(); !got.Eq(tt.want) {
+			t.Errorf("%v.CanonicalRotation() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	// Every rotation has the same canonical rotation
+	sl := Slice[Str]{"b", "a", "c", "a"}
+	want := sl.CanonicalRotation()
+	for i := 0; i < sl.Len(); i++ {
+		rotation := append(Slice[Str]{}, sl[i:]...)
+		rotation = append(rotation, sl[:i]...)
+		if got := rotation.CanonicalRotation(); !got.Eq(want) {
+			t.Errorf("%v.CanonicalRotation() = %v, want %v", rotation, got, want)
+		}
+	}
+}
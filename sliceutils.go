@@ -2,7 +2,11 @@ package sliceutils
 
 import (
 	"errors"
+	"math"
+	"math/rand"
 	"reflect"
+	"runtime"
+	"sync"
 )
 
 // Type aliases to enable the implementation of Value for the builtin types
@@ -28,10 +32,18 @@ type (
 )
 
 var (
-	ErrDoesNotExist = errors.New("value does not exist in slice")
-	ErrIsEmpty      = errors.New("slice is empty")
-	ErrIsNil        = errors.New("slice is nil")
-	ErrOutOfRange   = errors.New("index is out of range")
+	ErrDoesNotExist    = errors.New("value does not exist in slice")
+	ErrIsEmpty         = errors.New("slice is empty")
+	ErrIsNil           = errors.New("slice is nil")
+	ErrOutOfRange      = errors.New("index is out of range")
+	ErrLengthMismatch  = errors.New("slices have different lengths")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrNotInteger      = errors.New("slice values are not integers")
+	ErrNotNumeric      = errors.New("slice values are not numeric")
+	ErrNoConvergence   = errors.New("values did not converge")
+	ErrTooShort        = errors.New("slice has too few values")
+	ErrTypeMismatch    = errors.New("values have different types")
+	ErrNotSorted       = errors.New("slice is not sorted")
 )
 
 // Represents a slice value. It needs to implement Eq and Ord.
@@ -61,16 +73,79 @@ func New[T Value[any]](v ...T) Slice[T] {
 	return v
 }
 
+// # Iterate
+//
+// Create a new Slice of length n, starting at seed where every following value is f applied to the previous one
+//
+//	Iterate(1, 4, func(v T) T {return v*2}) return [1,2,4,8]
+func Iterate[T Value[any]](seed T, n int, f func(T) T) Slice[T] {
+	if n <= 0 {
+		return New[T]()
+	}
+	sl := make(Slice[T], 0, n)
+	sl.Push(seed)
+	for i := 1; i < n; i++ {
+		seed = f(seed)
+		sl.Push(seed)
+	}
+	return sl
+}
+
+// # Fixpoint
+//
+// Apply f repeatedly starting at seed, until the result is equal to the previous value. Return the result.
+// Returns ErrNoConvergence if no such value is found within maxIter applications of f.
+//
+//	Fixpoint(100, 10, func(v T) T {return v/2}) return 0
+func Fixpoint[T Value[any]](seed T, maxIter int, f func(T) T) (T, error) {
+	for i := 0; i < maxIter; i++ {
+		next := f(seed)
+		if next.Eq(seed) {
+			return next, nil
+		}
+		seed = next
+	}
+	return seed, ErrNoConvergence
+}
+
+// # MapTo
+//
+// Same as Map, but function f can return a different type than the one in the slice.
+// Go does not allow type parameters on methods, so this is a function instead.
+//
+//	MapTo([1,2,3], func(v Int) Str {return Str(strconv.Itoa(int(v)))}) return ["1","2","3"]
+func MapTo[T Value[any], R Value[any]](sl Slice[T], f func(T) R) Slice[R] {
+	mapped := make(Slice[R], sl.Len())
+	for i, v := range sl {
+		mapped[i] = f(v)
+	}
+	return mapped
+}
+
+// # FoldInto
+//
+// Same as Fold, but the accumulator can be of any type.
+// Go does not allow type parameters on methods, so this is a function instead.
+//
+//	FoldInto([1,2,3], 0, func(acc int, v Int) int {return acc+int(v)}) return 6
+func FoldInto[T Value[any], A any](sl Slice[T], init A, f func(A, T) A) A {
+	for _, v := range sl {
+		init = f(init, v)
+	}
+	return init
+}
+
 // # Pop
 //
 // Remove the last element of the slice and return the value
 func (sl *Slice[T]) Pop() (T, error) {
+	if sl == nil {
+		var zero T
+		return zero, ErrIsNil
+	}
 	if sl.IsEmpty() {
 		return sl.Default(), ErrIsEmpty
 	}
-	if sl == nil {
-		return sl.Default(), ErrIsNil
-	}
 	lastIndex := sl.Len() - 1
 	lastElement := (*sl)[lastIndex]
 
@@ -82,12 +157,13 @@ func (sl *Slice[T]) Pop() (T, error) {
 //
 // Remove the first element of the slice and return the value
 func (sl *Slice[T]) PopFront() (T, error) {
+	if sl == nil {
+		var zero T
+		return zero, ErrIsNil
+	}
 	if sl.IsEmpty() {
 		return sl.Default(), ErrIsEmpty
 	}
-	if sl == nil {
-		return sl.Default(), ErrIsNil
-	}
 	firstElement := (*sl)[0]
 	if sl.Len() == 1 {
 		*sl = New[T]()
@@ -102,24 +178,23 @@ func (sl *Slice[T]) PopFront() (T, error) {
 //
 // Remove the element at index n
 func (sl *Slice[T]) Remove(n uint) (T, error) {
+	if sl == nil {
+		var zero T
+		return zero, ErrIsNil
+	}
 	if sl.IsEmpty() {
 		return sl.Default(), ErrIsEmpty
 	}
-
-	if sl.Len() < int(n) {
+	if int(n) >= sl.Len() {
 		return sl.Default(), ErrOutOfRange
 	}
-	if sl == nil {
-		return sl.Default(), ErrIsNil
-	}
-	copy := *sl
-	value := copy[n]
-	if int(n) == copy.Len()-1 {
-		copy = copy[:n]
-	} else {
-		copy = append(copy[:n], copy[n+1:]...)
-	}
-	*sl = copy
+	value := (*sl)[n]
+
+	// Build a new slice, so that other slices sharing the backing array are left untouched
+	removed := make(Slice[T], 0, sl.Len()-1)
+	removed.Push((*sl)[:n]...)
+	removed.Push((*sl)[n+1:]...)
+	*sl = removed
 	return value, nil
 }
 
@@ -149,18 +224,16 @@ func (sl *Slice[T]) PushFront(v ...T) error {
 //
 // Add value(s) at index n, shifting all of the values after n to the right
 func (sl *Slice[T]) Insert(n int, v ...T) error {
-	if sl.Len() < n {
-		return ErrOutOfRange
-	}
 	if sl == nil {
 		return ErrIsNil
 	}
+	if sl.Len() < n {
+		return ErrOutOfRange
+	}
 	copy := *sl
+	n = copy.normalizeIndex(n)
 	if n < 0 {
-		for n < 0 {
-			n += copy.Len()
-		}
-		n++
+		return ErrOutOfRange
 	}
 	var result Slice[T]
 	if n == copy.Len() {
@@ -179,6 +252,30 @@ func (sl *Slice[T]) Insert(n int, v ...T) error {
 	return nil
 }
 
+// # InsertMany
+//
+// Return a new slice where the values of each entry in inserts are added before the index of the original slice given by its key.
+// The key sl.Len() adds the values at the end of the slice.
+//
+//	[1,2,3]InsertMany({0: [8], 2: [9,9], 3: [7]}) return [8,1,2,9,9,3,7]
+func (sl Slice[T]) InsertMany(inserts map[int]Slice[T]) (Slice[T], error) {
+	size := sl.Len()
+	for n, v := range inserts {
+		if n < 0 || n > sl.Len() {
+			return New[T](), ErrOutOfRange
+		}
+		size += v.Len()
+	}
+	result := make(Slice[T], 0, size)
+	for i := 0; i <= sl.Len(); i++ {
+		result.Push(inserts[i]...)
+		if i < sl.Len() {
+			result.Push(sl[i])
+		}
+	}
+	return result, nil
+}
+
 // # Count
 //
 // Return the total amount of occurances of v
@@ -212,6 +309,159 @@ func (sl Slice[T]) CountBy(f func(T) bool) int {
 	return count
 }
 
+// # CountByMany
+//
+// Return the total amount of occurances where each of the provided functions returns true, in a single pass
+//
+//	[-1,0,2,3]CountByMany(isNegative, isZero, isPositive) return [1,1,2]
+func (sl Slice[T]) CountByMany(fs ...func(T) bool) Slice[Int] {
+	counts := make(Slice[Int], len(fs))
+	for _, val := range sl {
+		for i, f := range fs {
+			if f(val) {
+				counts[i]++
+			}
+		}
+	}
+	return counts
+}
+
+// # UniqueWithCounts
+//
+// Return the distinct values in order of first occurance, and a parallel slice with the amount of occurances of each value
+//
+//	[1,2,1,3,1,2]UniqueWithCounts() return [1,2,3], [3,2,1]
+func (sl Slice[T]) UniqueWithCounts() (Slice[T], Slice[Int]) {
+	unique := New[T]()
+	counts := New[Int]()
+	for _, val := range sl {
+		if i, err := unique.FirstIndexOf(val); err == nil {
+			counts[i]++
+		} else {
+			unique.Push(val)
+			counts.Push(1)
+		}
+	}
+	return unique, counts
+}
+
+// # Modes
+//
+// Return the most frequent values in order of first occurance. Returns more than one value if there is a tie.
+//
+//	["a","b","a","c"]Modes() return ["a"]
+//	["a","b","b","a"]Modes() return ["a","b"]
+func (sl Slice[T]) Modes() Slice[T] {
+	unique, counts := sl.UniqueWithCounts()
+	maxCount, _ := counts.Max()
+	modes := New[T]()
+	for i, v := range unique {
+		if counts[i] == maxCount {
+			modes.Push(v)
+		}
+	}
+	return modes
+}
+
+// # Mode
+//
+// Return the most frequent value. If there is a tie, the value that occurs first is returned.
+// Values are counted in a single pass by their hash, and compared with Eq when their hashes collide.
+//
+//	["a","b","b","a","c"]Mode() return "a"
+//
+// Returns ErrIsEmpty if the slice is empty.
+func (sl Slice[T]) Mode() (T, error) {
+	if sl.IsEmpty() {
+		return sl.Default(), ErrIsEmpty
+	}
+	unique := New[T]()
+	counts := New[Int]()
+	buckets := make(map[uint64][]int)
+	for _, val := range sl {
+		h := hashValue(reflect.ValueOf(val))
+		found := false
+		for _, i := range buckets[h] {
+			if unique[i].Eq(val) {
+				counts[i]++
+				found = true
+				break
+			}
+		}
+		if !found {
+			buckets[h] = append(buckets[h], unique.Len())
+			unique.Push(val)
+			counts.Push(1)
+		}
+	}
+	best := 0
+	for i, count := range counts {
+		if count > counts[best] {
+			best = i
+		}
+	}
+	return unique[best], nil
+}
+
+// # Entropy
+//
+// Return the Shannon entropy in bits of the distribution of values in the slice.
+//
+//	[1,2,3,4]Entropy() return 2
+//	[1,1,1,1]Entropy() return 0
+func (sl Slice[T]) Entropy() (F64, error) {
+	if sl.IsEmpty() {
+		return 0, ErrIsEmpty
+	}
+	_, counts := sl.UniqueWithCounts()
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(sl.Len())
+		entropy -= p * math.Log2(p)
+	}
+	return F64(entropy), nil
+}
+
+// # JaccardSimilarity
+//
+// Treat both slices as sets and return the size of their intersection divided by the size of their union.
+// Returns 0 if both slices are empty.
+//
+//	[1,2,3]JaccardSimilarity([2,3,4]) return 0.5
+func (sl Slice[T]) JaccardSimilarity(other Slice[T]) F64 {
+	set, _ := sl.UniqueWithCounts()
+	otherSet, _ := other.UniqueWithCounts()
+	intersection := 0
+	for _, val := range set {
+		if otherSet.Contains(val) {
+			intersection++
+		}
+	}
+	union := set.Len() + otherSet.Len() - intersection
+	if union == 0 {
+		return 0
+	}
+	return F64(intersection) / F64(union)
+}
+
+// # MultisetIntersection
+//
+// Return the values that are in both slices, where each value is kept as many times as it occurs in the slice it occurs the least in.
+// The values are kept in the order of the receiver.
+//
+//	[1,1,2]MultisetIntersection([1,2,2]) return [1,2]
+func (sl Slice[T]) MultisetIntersection(other Slice[T]) Slice[T] {
+	unique, remaining := other.UniqueWithCounts()
+	intersection := New[T]()
+	for _, val := range sl {
+		if i, err := unique.FirstIndexOf(val); err == nil && remaining[i] > 0 {
+			remaining[i]--
+			intersection.Push(val)
+		}
+	}
+	return intersection
+}
+
 // # Contains
 //
 // Returns true if slice contains v
@@ -263,6 +513,91 @@ func (sl Slice[T]) IsPrefixOf(other Slice[T]) bool {
 	return true
 }
 
+// # FirstDifference
+//
+// Return the first index where slice sl and other differ, and true if there is a difference.
+// If one slice is a prefix of the other, the difference is at the length of the shorter slice.
+//
+//	[1,2,3]FirstDifference([1,5,3]) return 1, true
+//	[1,2]FirstDifference([1,2,3]) return 2, true
+//	[1,2]FirstDifference([1,2]) return -1, false
+func (sl Slice[T]) FirstDifference(other Slice[T]) (int, bool) {
+	length := min(sl.Len(), other.Len())
+	for i := 0; i < length; i++ {
+		if !sl[i].Eq(other[i]) {
+			return i, true
+		}
+	}
+	if sl.Len() != other.Len() {
+		return length, true
+	}
+	return -1, false
+}
+
+// # IsRotationOf
+//
+// Returns true if slice sl is a cyclic rotation of other
+//
+//	[3,1,2]IsRotationOf([1,2,3]) return true
+//	[3,2,1]IsRotationOf([1,2,3]) return false
+func (sl Slice[T]) IsRotationOf(other Slice[T]) bool {
+	if sl.Len() != other.Len() {
+		return false
+	}
+	if sl.IsEmpty() {
+		return true
+	}
+	doubled := make(Slice[T], 0, 2*sl.Len())
+	doubled = append(append(doubled, sl...), sl...)
+	for start := 0; start < sl.Len(); start++ {
+		if doubled[start : start+other.Len()].Eq(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// # PrefixFunction
+//
+// Return the KMP prefix function of the slice, which for every index i holds the length of the longest
+// proper prefix of sl[:i+1] that is also a suffix of it.
+//
+//	[1,2,1,2,1]PrefixFunction() return [0,0,1,2,3]
+//	[1,1,2,1,1,1]PrefixFunction() return [0,1,0,1,2,2]
+func (sl Slice[T]) PrefixFunction() Slice[Int] {
+	pi := make(Slice[Int], sl.Len())
+	for i := 1; i < sl.Len(); i++ {
+		k := pi[i-1]
+		for k > 0 && !sl[i].Eq(sl[k]) {
+			k = pi[k-1]
+		}
+		if sl[i].Eq(sl[k]) {
+			k++
+		}
+		pi[i] = k
+	}
+	return pi
+}
+
+// # Period
+//
+// Return the length of the smallest block that can be repeated to build the whole slice, and true if it is shorter than the slice.
+// If there is no such block, the length of the slice and false is returned.
+//
+//	[1,2,1,2,1,2]Period() return 2, true
+//	[1,2,1,2,1]Period() return 5, false
+func (sl Slice[T]) Period() (int, bool) {
+	if sl.IsEmpty() {
+		return 0, false
+	}
+	n := sl.Len()
+	period := n - int(sl.PrefixFunction()[n-1])
+	if period < n && n%period == 0 {
+		return period, true
+	}
+	return n, false
+}
+
 // # ForEach
 //
 // Loop through all elements in the slice and apply a provided function to the value
@@ -298,6 +633,74 @@ func (sl Slice[T]) MapWhile(f func(v T) *T) Slice[T] {
 	return mappedSlice
 }
 
+// # ParallelMap
+//
+// Same as Map, but the slice is divided into batches that are mapped concurrently by the given amount of workers.
+// The result keeps the order of the slice. If workers is less than 1, runtime.GOMAXPROCS(0) workers are used.
+//
+//	[1,2,3,4]ParallelMap(2, func(v T) T {return v*2}) return [2,4,6,8]
+//
+// # Caution!
+//
+// Function f is called from several goroutines at once, so it has to be safe for concurrent use.
+func (sl Slice[T]) ParallelMap(workers int, f func(v T) T) Slice[T] {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	mappedSlice := make(Slice[T], sl.Len())
+	if sl.IsEmpty() {
+		return mappedSlice
+	}
+
+	bounds := batchBounds(sl.Len(), workers)
+	var wg sync.WaitGroup
+	for w := 1; w < len(bounds); w++ {
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			for i := from; i < to; i++ {
+				mappedSlice[i] = f(sl[i])
+			}
+		}(bounds[w-1], bounds[w])
+	}
+	wg.Wait()
+	return mappedSlice
+}
+
+// # MapFixpoint
+//
+// Apply Map with function f repeatedly, until the result is equal to the previous slice. Return the result and the amount of times Map was applied.
+// Returns ErrNoConvergence if the slice is still changing after maxIter applications of Map.
+//
+//	[100,7]MapFixpoint(func(v T) T {return v/2}, 10) return [0,0], 8
+func (sl Slice[T]) MapFixpoint(f func(v T) T, maxIter int) (Slice[T], int, error) {
+	for i := 1; i <= maxIter; i++ {
+		next := sl.Map(f)
+		if next.Eq(sl) {
+			return next, i, nil
+		}
+		sl = next
+	}
+	return sl, max(maxIter, 0), ErrNoConvergence
+}
+
+// # MapPairs
+//
+// Apply a provided function to every pair of adjacent elements. Return the result, which is one element shorter than the slice.
+// Returns an empty slice if the slice has less than two elements.
+//
+//	[1,3,7]MapPairs(func(a, b T) T {return (a+b)/2}) return [2,5]
+func (sl Slice[T]) MapPairs(f func(a, b T) T) Slice[T] {
+	if sl.Len() < 2 {
+		return New[T]()
+	}
+	mappedSlice := make(Slice[T], 0, sl.Len()-1)
+	for i := 1; i < sl.Len(); i++ {
+		mappedSlice.Push(f(sl[i-1], sl[i]))
+	}
+	return mappedSlice
+}
+
 // # StepBy
 //
 // Return a Slice that starts at index 0, and only contains elements with n steps in between
@@ -322,6 +725,21 @@ func (sl Slice[T]) Filter(f func(v T) bool) Slice[T] {
 	return filteredSlice
 }
 
+// # FilterIndices
+//
+// Apply a filter function on all elements and return the indexes of all elements that returned true
+//
+//	[1,4,3,6]FilterIndices(func(v T) bool {return v%2==0}) return [1,3]
+func (sl Slice[T]) FilterIndices(f func(v T) bool) Slice[Int] {
+	indices := New[Int]()
+	for i, v := range sl {
+		if f(v) {
+			indices.Push(Int(i))
+		}
+	}
+	return indices
+}
+
 // # FilterMap
 //
 // First apply Filter, and then Map. Return the result.
@@ -339,22 +757,29 @@ func (sl Slice[T]) IsNested() bool {
 	return reflect.TypeOf(sl[0]).Kind() == reflect.Slice
 }
 
+// Returns n as an index from the start of the slice, where negative values of n count from the end.
+// -1 is the last index and -Len() is the first. The result is not checked to be in range.
+func (sl Slice[T]) normalizeIndex(n int) int {
+	if n < 0 {
+		return n + sl.Len()
+	}
+	return n
+}
+
 // # Get
 //
-// Get the value at index n without modifying the slice
+// Get the value at index n without modifying the slice. Negative indexes count from the end of the slice.
+//
+//	[1,2,3]Get(-1) return 3
+//	[1,2,3]Get(-3) return 1
 func (sl Slice[T]) Get(n int) (T, error) {
 	if sl.IsEmpty() {
 		return sl.Default(), ErrIsEmpty
 	}
-	if sl.Len() < n {
+	n = sl.normalizeIndex(n)
+	if n < 0 || n >= sl.Len() {
 		return sl.Default(), ErrOutOfRange
 	}
-	if n < 0 {
-		for n < 0 {
-			n += sl.Len()
-		}
-		n++
-	}
 	return sl[n], nil
 }
 
@@ -386,6 +811,21 @@ func (sl Slice[T]) Repeat(n uint) Slice[T] {
 	return sl
 }
 
+// # RepeatEach
+//
+// Create a new slice where every element is repeated n times in place. Return the new slice.
+//
+//	[1,2]RepeatEach(2) return [1,1,2,2]
+func (sl Slice[T]) RepeatEach(n uint) Slice[T] {
+	repeated := make(Slice[T], 0, sl.Len()*int(n))
+	for _, v := range sl {
+		for i := uint(0); i < n; i++ {
+			repeated.Push(v)
+		}
+	}
+	return repeated
+}
+
 // # Reverse
 //
 // Create a copy of the slice and reverse it. Return the reversed slice.
@@ -397,6 +837,33 @@ func (sl Slice[T]) Reverse() Slice[T] {
 	return rev
 }
 
+// # Permute
+//
+// Return a new slice where the value at index i is the value at index perm[i] of the original slice.
+//
+//	[10,20,30]Permute([2,0,1]) return [30,10,20]
+//
+// Returns ErrLengthMismatch if perm is not the same length as the slice, ErrOutOfRange if an index
+// in perm is out of range and ErrInvalidArgument if an index appears more than once.
+func (sl Slice[T]) Permute(perm Slice[Int]) (Slice[T], error) {
+	if perm.Len() != sl.Len() {
+		return New[T](), ErrLengthMismatch
+	}
+	seen := make([]bool, sl.Len())
+	permuted := make(Slice[T], 0, sl.Len())
+	for _, i := range perm {
+		if i < 0 || int(i) >= sl.Len() {
+			return New[T](), ErrOutOfRange
+		}
+		if seen[i] {
+			return New[T](), ErrInvalidArgument
+		}
+		seen[i] = true
+		permuted.Push(sl[i])
+	}
+	return permuted, nil
+}
+
 // # Concat
 //
 // Concatenate two slices into one slice.
@@ -438,6 +905,190 @@ func (sl Slice[T]) Max() (T, error) {
 	return min, err
 }
 
+// # MinWithIndex
+//
+// Return the minimum value of the slice and the index of its first occurance.
+//
+//	[3,1,2,1]MinWithIndex() return 1, 1
+func (sl Slice[T]) MinWithIndex() (T, int, error) {
+	if sl.IsEmpty() {
+		return sl.Default(), -1, ErrIsEmpty
+	}
+	min, index := sl[0], 0
+	for i, v := range sl {
+		if v.Lt(min) {
+			min, index = v, i
+		}
+	}
+	return min, index, nil
+}
+
+// # MaxWithIndex
+//
+// Return the maximum value of the slice and the index of its first occurance.
+//
+//	[1,3,2,3]MaxWithIndex() return 3, 1
+func (sl Slice[T]) MaxWithIndex() (T, int, error) {
+	if sl.IsEmpty() {
+		return sl.Default(), -1, ErrIsEmpty
+	}
+	max, index := sl[0], 0
+	for i, v := range sl {
+		if v.Gt(max) {
+			max, index = v, i
+		}
+	}
+	return max, index, nil
+}
+
+// # CumMin
+//
+// Return a slice with the minimum value up to and including each index.
+//
+//	[3,1,4,1,5]CumMin() return [3,1,1,1,1]
+func (sl Slice[T]) CumMin() (Slice[T], error) {
+	if sl.IsEmpty() {
+		return New[T](), ErrIsEmpty
+	}
+	cum := make(Slice[T], 0, sl.Len())
+	min := sl[0]
+	for _, v := range sl {
+		if v.Lt(min) {
+			min = v
+		}
+		cum.Push(min)
+	}
+	return cum, nil
+}
+
+// # CumMax
+//
+// Return a slice with the maximum value up to and including each index.
+//
+//	[3,1,4,1,5]CumMax() return [3,3,4,4,5]
+func (sl Slice[T]) CumMax() (Slice[T], error) {
+	if sl.IsEmpty() {
+		return New[T](), ErrIsEmpty
+	}
+	cum := make(Slice[T], 0, sl.Len())
+	max := sl[0]
+	for _, v := range sl {
+		if v.Gt(max) {
+			max = v
+		}
+		cum.Push(max)
+	}
+	return cum, nil
+}
+
+// # LongestIncreasingRun
+//
+// Return the start index and length of the longest contiguous run of strictly increasing values.
+// If several runs have the same length, the first one is returned.
+//
+//	[1,2,1,2,3,0]LongestIncreasingRun() return 2, 3
+func (sl Slice[T]) LongestIncreasingRun() (start int, length int) {
+	if sl.IsEmpty() {
+		return 0, 0
+	}
+	length = 1
+	runStart := 0
+	for i := 1; i < sl.Len(); i++ {
+		if !sl[i-1].Lt(sl[i]) {
+			runStart = i
+		}
+		if i-runStart+1 > length {
+			start, length = runStart, i-runStart+1
+		}
+	}
+	return start, length
+}
+
+// # LIS
+//
+// Return one of the longest strictly increasing subsequences of the slice, in O(n log n).
+//
+//	[3,1,4,1,5,9,2,6]LIS() return [1,4,5,6]
+func (sl Slice[T]) LIS() Slice[T] {
+	// tails[k] is the index of the smallest tail of all increasing subsequences of length k+1
+	tails := make([]int, 0, sl.Len())
+	prev := make([]int, sl.Len())
+	for i, v := range sl {
+		low, high := 0, len(tails)
+		for low < high {
+			mid := (low + high) / 2
+			if sl[tails[mid]].Lt(v) {
+				low = mid + 1
+			} else {
+				high = mid
+			}
+		}
+		if low > 0 {
+			prev[i] = tails[low-1]
+		} else {
+			prev[i] = -1
+		}
+		if low == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[low] = i
+		}
+	}
+
+	lis := make(Slice[T], len(tails))
+	if len(tails) == 0 {
+		return lis
+	}
+	for i, k := tails[len(tails)-1], len(tails)-1; k >= 0; i, k = prev[i], k-1 {
+		lis[k] = sl[i]
+	}
+	return lis
+}
+
+// # MonotonicStack
+//
+// Return, for every element, the index of the closest element before it that is greater than it,
+// or smaller than it if keepGreater is false. The index is -1 if there is no such element.
+//
+//	[3,1,2,4]MonotonicStack(true) return [-1,0,0,-1]
+//	[3,1,2,4]MonotonicStack(false) return [-1,-1,1,2]
+func (sl Slice[T]) MonotonicStack(keepGreater bool) Slice[Int] {
+	nearest := make(Slice[Int], sl.Len())
+	stack := make([]int, 0, sl.Len())
+	for i, v := range sl {
+		// Pop every index that can never be the answer for this or any later element
+		for len(stack) > 0 {
+			top := sl[stack[len(stack)-1]]
+			if (keepGreater && top.Gt(v)) || (!keepGreater && top.Lt(v)) {
+				break
+			}
+			stack = stack[:len(stack)-1]
+		}
+		nearest[i] = -1
+		if len(stack) > 0 {
+			nearest[i] = Int(stack[len(stack)-1])
+		}
+		stack = append(stack, i)
+	}
+	return nearest
+}
+
+// # MinBy
+//
+// Return the minimum value of the slice based on the function f.
+func (sl Slice[T]) MinBy(f func(T) T) (T, error) {
+	if sl.IsEmpty() {
+		return sl.Default(), ErrIsEmpty
+	}
+	min, minKey := sl[0], f(sl[0])
+	for _, v := range sl[1:] {
+		if key := f(v); key.Lt(minKey) {
+			min, minKey = v, key
+		}
+	}
+	return min, nil
+}
+
 // # MaxBy
 //
 // Return the maximum value of the slice based on the function f.
@@ -445,10 +1096,10 @@ func (sl Slice[T]) MaxBy(f func(T) T) (T, error) {
 	if sl.IsEmpty() {
 		return sl.Default(), ErrIsEmpty
 	}
-	max := sl.Default()
-	for _, v := range sl {
-		if f(v).Gt(max) {
-			max = v
+	max, maxKey := sl[0], f(sl[0])
+	for _, v := range sl[1:] {
+		if key := f(v); key.Gt(maxKey) {
+			max, maxKey = v, key
 		}
 	}
 	return max, nil
@@ -472,11 +1123,9 @@ func (sl Slice[T]) Last() (T, error) {
 //
 // Returns true if the element at index n is the same as value
 func (sl Slice[T]) IndexIs(n int, value T) bool {
-	if n < 0 {
-		for n < 0 {
-			n += sl.Len()
-		}
-		n++
+	n = sl.normalizeIndex(n)
+	if n < 0 || n >= sl.Len() {
+		return false
 	}
 	return sl[n].Eq(value)
 }
@@ -559,8 +1208,8 @@ func (sl Slice[T]) AllIndexesOf(v T) (Slice[Int], error) {
 	if !sl.Contains(v) {
 		return indexes, ErrDoesNotExist
 	}
-	for i, v := range sl {
-		if v.Eq(v) {
+	for i, val := range sl {
+		if val.Eq(v) {
 			indexes.Push(Int(i))
 		}
 	}
@@ -579,6 +1228,61 @@ func (sl Slice[T]) Fold(init V, f func(V, T) V) V {
 	return init
 }
 
+// # FoldWhile
+//
+// Works the same as Fold, but stops accumulating as soon as function f returns false
+//
+//	[1,2,3,4]FoldWhile(0, func(acc V, v T) (V, bool) {return acc+v, acc+v < 3}) return 3
+func (sl Slice[T]) FoldWhile(init V, f func(V, T) (V, bool)) V {
+	for _, v := range sl {
+		var ok bool
+		if init, ok = f(init, v); !ok {
+			break
+		}
+	}
+	return init
+}
+
+// # Scan
+//
+// Works the same as Fold, but returns every intermediate value of the accumulator. The initial value is not included,
+// so the result has the same length as the slice.
+//
+//	[1,2,3]Scan(0, func(acc, v T) T {return acc+v}) return [1,3,6]
+//	[1,2,3]Scan(10, func(acc, v T) T {return acc-v}) return [9,7,4]
+func (sl Slice[T]) Scan(init T, f func(acc, v T) T) Slice[T] {
+	scanned := make(Slice[T], sl.Len())
+	for i, v := range sl {
+		init = f(init, v)
+		scanned[i] = init
+	}
+	return scanned
+}
+
+// # MergeAdjacent
+//
+// Walk through the slice and combine the current value with the next element using merge, as long as canMerge returns true for them.
+// When canMerge returns false, the current value is added to the result and the next element becomes the current value.
+//
+//	[1,1,3,2,2]MergeAdjacent(func(a, b T) bool {return a == b}, func(a, b T) T {return a+b}) return [2,3,4]
+func (sl Slice[T]) MergeAdjacent(canMerge func(a, b T) bool, merge func(a, b T) T) Slice[T] {
+	merged := New[T]()
+	if sl.IsEmpty() {
+		return merged
+	}
+	current := sl[0]
+	for _, v := range sl[1:] {
+		if canMerge(current, v) {
+			current = merge(current, v)
+		} else {
+			merged.Push(current)
+			current = v
+		}
+	}
+	merged.Push(current)
+	return merged
+}
+
 // # Reduce
 //
 // Works the same as Fold but starts accumulating at the first element of the slice
@@ -590,6 +1294,64 @@ func (sl Slice[T]) Reduce(f func(acc, v T) T) (T, error) {
 	return acc, err
 }
 
+// # Rolling
+//
+// Apply function f on every window of the given size and return one result per window.
+//
+//	[1,3,2,5]Rolling(2, max) return [3,3,5]
+//
+// Returns ErrInvalidArgument if window is 0 and ErrOutOfRange if window is larger than the slice.
+func (sl Slice[T]) Rolling(window uint, f func(Slice[T]) T) (Slice[T], error) {
+	if window == 0 {
+		return New[T](), ErrInvalidArgument
+	}
+	if int(window) > sl.Len() {
+		return New[T](), ErrOutOfRange
+	}
+	size := int(window)
+	rolled := make(Slice[T], 0, sl.Len()-size+1)
+	for i := 0; i+size <= sl.Len(); i++ {
+		rolled.Push(f(sl[i : i+size]))
+	}
+	return rolled, nil
+}
+
+// # WindowReduce
+//
+// Apply function f on windows of the given size that start step elements apart, and return one result per window.
+// Only full windows are reduced.
+//
+//	[1,5,2,4,3,6]WindowReduce(2, 2, max) return [5,4,6]
+//	[1,5,2,4,3,6]WindowReduce(3, 1, max) return [5,5,4,6]
+//
+// Returns ErrInvalidArgument if size or step is 0 and ErrOutOfRange if size is larger than the slice.
+func (sl Slice[T]) WindowReduce(size, step uint, f func(Slice[T]) T) (Slice[T], error) {
+	if size == 0 || step == 0 {
+		return New[T](), ErrInvalidArgument
+	}
+	if int(size) > sl.Len() {
+		return New[T](), ErrOutOfRange
+	}
+	reduced := make(Slice[T], 0, (sl.Len()-int(size))/int(step)+1)
+	for i := 0; i+int(size) <= sl.Len(); i += int(step) {
+		reduced.Push(f(sl[i : i+int(size)]))
+	}
+	return reduced, nil
+}
+
+// # RollingMode
+//
+// Return the most frequent value in every window of the given size. Ties go to the value that occurs first in the window.
+//
+//	["a","a","b","b","b"]RollingMode(3) return ["a","b","b"]
+//
+// Returns ErrInvalidArgument if window is 0 and ErrOutOfRange if window is larger than the slice.
+func (sl Slice[T]) RollingMode(window uint) (Slice[T], error) {
+	return sl.Rolling(window, func(w Slice[T]) T {
+		return w.Modes()[0]
+	})
+}
+
 // # Skip
 //
 // Return a new slice where n amount of elements are skipped
@@ -634,6 +1396,20 @@ func (sl Slice[T]) TakeWhile(f func(T) bool) Slice[T] {
 	return sl
 }
 
+// # SplitAtPredicate
+//
+// Split the slice at the first element where f returns false. The failing element is the first element of after.
+//
+//	[1,2,5,3]SplitAtPredicate(func(v T) bool {return v < 3}) return [1,2], [5,3]
+func (sl Slice[T]) SplitAtPredicate(f func(T) bool) (before Slice[T], after Slice[T]) {
+	for i, v := range sl {
+		if !f(v) {
+			return sl[:i:i], sl[i:]
+		}
+	}
+	return sl[:sl.Len():sl.Len()], New[T]()
+}
+
 // # Zip
 //
 // Return a new slice where two slices are zipped together into one slice
@@ -659,10 +1435,71 @@ func (sl Slice[T]) Zip(other Slice[T]) Slice[T] {
 	}
 }
 
-// # All
+// # Unzip
+//
+// Split an interleaved slice into the elements at even indexes and the elements at odd indexes. Works as the inverse of Zip
+// for slices of equal length. If the length is odd, the last element goes to the first slice.
+//
+//	[1,4,2,5,3]Unzip() return [1,2,3], [4,5]
+func (sl Slice[T]) Unzip() (Slice[T], Slice[T]) {
+	even := make(Slice[T], 0, (sl.Len()+1)/2)
+	odd := make(Slice[T], 0, sl.Len()/2)
+	for i, v := range sl {
+		if i%2 == 0 {
+			even.Push(v)
+		} else {
+			odd.Push(v)
+		}
+	}
+	return even, odd
+}
+
+// # Intersperse
+//
+// Return a new slice where sep is placed between every pair of adjacent elements.
+//
+//	[1,2,3]Intersperse(0) return [1,0,2,0,3]
+//	[1]Intersperse(0) return [1]
+func (sl Slice[T]) Intersperse(sep T) Slice[T] {
+	if sl.IsEmpty() {
+		return New[T]()
+	}
+	interspersed := make(Slice[T], 0, 2*sl.Len()-1)
+	for i, v := range sl {
+		if i > 0 {
+			interspersed.Push(sep)
+		}
+		interspersed.Push(v)
+	}
+	return interspersed
+}
+
+// # InterleaveFill
+//
+// Return a new slice where two slices are interleaved, and the shorter slice is padded with fill.
+// The result always has twice the length of the longer slice.
+//
+//	[1,2,3]InterleaveFill([4], 0) return [1,4,2,0,3,0]
+func (sl Slice[T]) InterleaveFill(other Slice[T], fill T) Slice[T] {
+	length := max(sl.Len(), other.Len())
+	interleaved := make(Slice[T], 0, 2*length)
+	for i := 0; i < length; i++ {
+		a, b := fill, fill
+		if i < sl.Len() {
+			a = sl[i]
+		}
+		if i < other.Len() {
+			b = other[i]
+		}
+		interleaved.Push(a, b)
+	}
+	return interleaved
+}
+
+// # Every
 //
 // Return true if function f returns true on all elements of the slice
-func (sl Slice[T]) All(f func(v T) bool) bool {
+func (sl Slice[T]) Every(f func(v T) bool) bool {
 	for _, v := range sl {
 		if !f(v) {
 			return false
@@ -671,6 +1508,15 @@ func (sl Slice[T]) All(f func(v T) bool) bool {
 	return true
 }
 
+// # All
+//
+// Return true if function f returns true on all elements of the slice.
+//
+// Deprecated: use Every.
+func (sl Slice[T]) All(f func(v T) bool) bool {
+	return sl.Every(f)
+}
+
 // # Any
 //
 // Return true if function f returns true on any element of the slice
@@ -683,6 +1529,21 @@ func (sl Slice[T]) Any(f func(v T) bool) bool {
 	return false
 }
 
+// # AllEqual
+//
+// Return true if all elements of the slice are equal to the first element. Returns true for empty slices.
+//
+//	[1,1,1]AllEqual() return true
+//	[1,2,1]AllEqual() return false
+func (sl Slice[T]) AllEqual() bool {
+	for i := 1; i < sl.Len(); i++ {
+		if !sl[i].Eq(sl[0]) {
+			return false
+		}
+	}
+	return true
+}
+
 // # Enumerate
 //
 // Works like ForEach, but also provides the index
@@ -692,6 +1553,61 @@ func (sl Slice[T]) Enumerate(f func(int, T)) {
 	}
 }
 
+// # Inspect
+//
+// Call function f on every element and return the slice unchanged. Useful for debugging chains of methods.
+//
+//	[1,2,3]Filter(isOdd).Inspect(log).Map(double) logs 1, 3 and return [2,6]
+func (sl Slice[T]) Inspect(f func(T)) Slice[T] {
+	for _, v := range sl {
+		f(v)
+	}
+	return sl
+}
+
+// # TrainTestSplit
+//
+// Shuffle a copy of the slice using r and split it into train and test, where train holds the
+// first ratio * sl.Len() elements (rounded down) and test holds the rest.
+//
+//	[1,2,3,4,5]TrainTestSplit(0.8, r) return 4 random elements, the 1 remaining element
+//
+// Returns ErrInvalidArgument if ratio is not between 0 and 1 (including NaN), or r is nil.
+func (sl Slice[T]) TrainTestSplit(ratio F64, r *rand.Rand) (train Slice[T], test Slice[T], err error) {
+	if !(ratio >= 0 && ratio <= 1) || r == nil {
+		return New[T](), New[T](), ErrInvalidArgument
+	}
+	shuffled := make(Slice[T], sl.Len())
+	copy(shuffled, sl)
+	r.Shuffle(shuffled.Len(), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	n := int(ratio * F64(sl.Len()))
+	return shuffled[:n:n], shuffled[n:], nil
+}
+
+// # ReservoirSample
+//
+// Return k elements chosen uniformly at random in a single pass, using Algorithm R with r as the source of randomness.
+// k is clamped to the length of the slice. If r is nil, the global source of math/rand is used.
+//
+//	[1,2,3,4,5]ReservoirSample(2, r) return 2 random elements
+func (sl Slice[T]) ReservoirSample(k uint, r *rand.Rand) Slice[T] {
+	size := min(int(k), sl.Len())
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+	reservoir := make(Slice[T], size)
+	copy(reservoir, sl[:size])
+	for i := size; i < sl.Len(); i++ {
+		if j := intn(i + 1); j < size {
+			reservoir[j] = sl[i]
+		}
+	}
+	return reservoir
+}
+
 // # Copy
 //
 // Returns a copy of the slice.
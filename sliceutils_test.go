@@ -0,0 +1,1181 @@
+package sliceutils
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPermute(t *testing.T) {
+	sl := Slice[Str]{"c", "a", "b"}
+
+	// Sort the indices by the values they point to, like an ArgSort would
+	order := Slice[Int]{0, 1, 2}
+	order.SortBy(func(a, b Int) bool { return !sl[b].Lt(sl[a]) })
+
+	tests := []struct {
+		name string
+		perm Slice[Int]
+		want Slice[Str]
+		err  error
+	}{
+		{"argsort", order, Slice[Str]{"a", "b", "c"}, nil},
+		{"hand written", Slice[Int]{2, 0, 1}, Slice[Str]{"b", "c", "a"}, nil},
+		{"identity", Slice[Int]{0, 1, 2}, Slice[Str]{"c", "a", "b"}, nil},
+		{"wrong length", Slice[Int]{0, 1}, Slice[Str]{}, ErrLengthMismatch},
+		{"out of range", Slice[Int]{0, 1, 3}, Slice[Str]{}, ErrOutOfRange},
+		{"negative", Slice[Int]{0, -1, 2}, Slice[Str]{}, ErrOutOfRange},
+		{"repeated index", Slice[Int]{0, 0, 1}, Slice[Str]{}, ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		got, err := sl.Permute(tt.perm)
+		if err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%s: Permute(%v) = %v, %v, want %v, %v", tt.name, tt.perm, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestUniqueWithCounts(t *testing.T) {
+	tests := []struct {
+		sl     Slice[Int]
+		unique Slice[Int]
+		counts Slice[Int]
+	}{
+		{Slice[Int]{1, 2, 1, 3, 1, 2}, Slice[Int]{1, 2, 3}, Slice[Int]{3, 2, 1}},
+		{Slice[Int]{3, 3, 3}, Slice[Int]{3}, Slice[Int]{3}},
+		{Slice[Int]{}, Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		unique, counts := tt.sl.UniqueWithCounts()
+		if !unique.Eq(tt.unique) || !counts.Eq(tt.counts) {
+			t.Errorf("%v.UniqueWithCounts() = %v, %v, want %v, %v", tt.sl, unique, counts, tt.unique, tt.counts)
+		}
+	}
+}
+
+func TestIsRotationOf(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[Int]
+		want      bool
+	}{
+		{Slice[Int]{3, 1, 2}, Slice[Int]{1, 2, 3}, true},
+		{Slice[Int]{1, 2, 3}, Slice[Int]{1, 2, 3}, true},
+		{Slice[Int]{3, 2, 1}, Slice[Int]{1, 2, 3}, false},
+		{Slice[Int]{1, 2}, Slice[Int]{1, 2, 1}, false},
+		{Slice[Int]{}, Slice[Int]{}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.IsRotationOf(tt.other); got != tt.want {
+			t.Errorf("%v.IsRotationOf(%v) = %v, want %v", tt.sl, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestJaccardSimilarity(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[Str]
+		want      F64
+	}{
+		{Slice[Str]{"a", "b"}, Slice[Str]{"b", "a"}, 1},
+		{Slice[Str]{"a", "b"}, Slice[Str]{"c", "d"}, 0},
+		{Slice[Str]{"a", "b", "c"}, Slice[Str]{"b", "c", "d"}, 0.5},
+		{Slice[Str]{"a", "a", "b"}, Slice[Str]{"a"}, 0.5},
+		{Slice[Str]{}, Slice[Str]{}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.JaccardSimilarity(tt.other); got != tt.want {
+			t.Errorf("%v.JaccardSimilarity(%v) = %v, want %v", tt.sl, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestMinMaxWithIndex(t *testing.T) {
+	sl := Slice[Int]{3, 1, 4, 1, 5, 9, 2, 9}
+	if v, i, err := sl.MinWithIndex(); v != 1 || i != 1 || err != nil {
+		t.Errorf("MinWithIndex() = %v, %v, %v, want 1, 1, nil", v, i, err)
+	}
+	if v, i, err := sl.MaxWithIndex(); v != 9 || i != 5 || err != nil {
+		t.Errorf("MaxWithIndex() = %v, %v, %v, want 9, 5, nil", v, i, err)
+	}
+
+	empty := Slice[Int]{}
+	if _, _, err := empty.MinWithIndex(); err != ErrIsEmpty {
+		t.Errorf("MinWithIndex() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, _, err := empty.MaxWithIndex(); err != ErrIsEmpty {
+		t.Errorf("MaxWithIndex() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestRolling(t *testing.T) {
+	sum := func(w Slice[Int]) Int {
+		total := Int(0)
+		for _, v := range w {
+			total += v
+		}
+		return total
+	}
+	max := func(w Slice[Int]) Int {
+		m, _ := w.Max()
+		return m
+	}
+	sl := Slice[Int]{1, 3, 2, 5, 4}
+
+	tests := []struct {
+		name   string
+		window uint
+		f      func(Slice[Int]) Int
+		want   Slice[Int]
+		err    error
+	}{
+		{"max", 2, max, Slice[Int]{3, 3, 5, 5}, nil},
+		{"sum", 3, sum, Slice[Int]{6, 10, 11}, nil},
+		{"whole slice", 5, sum, Slice[Int]{15}, nil},
+		{"zero window", 0, sum, Slice[Int]{}, ErrInvalidArgument},
+		{"too large window", 6, sum, Slice[Int]{}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		got, err := sl.Rolling(tt.window, tt.f)
+		if err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%s: Rolling(%v) = %v, %v, want %v, %v", tt.name, tt.window, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestCumMinMax(t *testing.T) {
+	ints := Slice[Int]{3, 1, 4, 1, 5}
+	if got, err := ints.CumMax(); err != nil || !got.Eq(Slice[Int]{3, 3, 4, 4, 5}) {
+		t.Errorf("%v.CumMax() = %v, %v", ints, got, err)
+	}
+	if got, err := ints.CumMin(); err != nil || !got.Eq(Slice[Int]{3, 1, 1, 1, 1}) {
+		t.Errorf("%v.CumMin() = %v, %v", ints, got, err)
+	}
+
+	strs := Slice[Str]{"b", "a", "c"}
+	if got, err := strs.CumMax(); err != nil || !got.Eq(Slice[Str]{"b", "b", "c"}) {
+		t.Errorf("%v.CumMax() = %v, %v", strs, got, err)
+	}
+	if got, err := strs.CumMin(); err != nil || !got.Eq(Slice[Str]{"b", "a", "a"}) {
+		t.Errorf("%v.CumMin() = %v, %v", strs, got, err)
+	}
+
+	if _, err := (Slice[Int]{}).CumMax(); err != ErrIsEmpty {
+		t.Errorf("CumMax() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+	if _, err := (Slice[Int]{}).CumMin(); err != ErrIsEmpty {
+		t.Errorf("CumMin() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestMapPairs(t *testing.T) {
+	midpoint := func(a, b F64) F64 { return (a + b) / 2 }
+	tests := []struct {
+		sl   Slice[F64]
+		want Slice[F64]
+	}{
+		{Slice[F64]{1, 3, 7, 8}, Slice[F64]{2, 5, 7.5}},
+		{Slice[F64]{1, 2}, Slice[F64]{1.5}},
+		{Slice[F64]{1}, Slice[F64]{}},
+		{Slice[F64]{}, Slice[F64]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.MapPairs(midpoint); !got.Eq(tt.want) {
+			t.Errorf("%v.MapPairs(midpoint) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
+
+func TestLongestIncreasingRun(t *testing.T) {
+	tests := []struct {
+		sl            Slice[Int]
+		start, length int
+	}{
+		{Slice[Int]{1, 2, 0, 1, 2, 3, 1, 2}, 2, 4},
+		{Slice[Int]{1, 2, 3, 0, 1, 2}, 0, 3},
+		{Slice[Int]{3, 2, 1}, 0, 1},
+		{Slice[Int]{1, 1, 1}, 0, 1},
+		{Slice[Int]{}, 0, 0},
+	}
+	for _, tt := range tests {
+		if start, length := tt.sl.LongestIncreasingRun(); start != tt.start || length != tt.length {
+			t.Errorf("%v.LongestIncreasingRun() = %v, %v, want %v, %v", tt.sl, start, length, tt.start, tt.length)
+		}
+	}
+}
+
+func TestLIS(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{3, 1, 4, 1, 5, 9, 2, 6}, Slice[Int]{1, 4, 5, 6}},
+		{Slice[Int]{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15}, Slice[Int]{0, 2, 6, 9, 11, 15}},
+		{Slice[Int]{5, 4, 3}, Slice[Int]{3}},
+		{Slice[Int]{2, 2, 2}, Slice[Int]{2}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.LIS(); !got.Eq(tt.want) {
+			t.Errorf("%v.LIS() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
+
+func TestIterate(t *testing.T) {
+	double := func(v Int) Int { return v * 2 }
+	tests := []struct {
+		n    int
+		want Slice[Int]
+	}{
+		{5, Slice[Int]{1, 2, 4, 8, 16}},
+		{1, Slice[Int]{1}},
+		{0, Slice[Int]{}},
+		{-1, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := Iterate(1, tt.n, double); !got.Eq(tt.want) {
+			t.Errorf("Iterate(1, %v, double) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFixpoint(t *testing.T) {
+	half := func(v Int) Int { return v / 2 }
+	if got, err := Fixpoint(Int(100), 10, half); got != 0 || err != nil {
+		t.Errorf("Fixpoint(100, 10, half) = %v, %v, want 0, nil", got, err)
+	}
+
+	// Newton's method for the square root of 2 converges to a value that maps to itself
+	sqrt := func(v F64) F64 { return (v + 2/v) / 2 }
+	if got, err := Fixpoint(F64(1), 100, sqrt); err != nil || got*got < 1.9999999 || got*got > 2.0000001 {
+		t.Errorf("Fixpoint(1, 100, sqrt) = %v, %v, want the square root of 2", got, err)
+	}
+
+	inc := func(v Int) Int { return v + 1 }
+	if got, err := Fixpoint(Int(0), 5, inc); got != 5 || err != ErrNoConvergence {
+		t.Errorf("Fixpoint(0, 5, inc) = %v, %v, want 5, %v", got, err, ErrNoConvergence)
+	}
+}
+
+func TestSplitAtPredicate(t *testing.T) {
+	small := func(v Int) bool { return v < 3 }
+	tests := []struct {
+		name          string
+		sl            Slice[Int]
+		before, after Slice[Int]
+	}{
+		{"fails early", Slice[Int]{5, 1, 2}, Slice[Int]{}, Slice[Int]{5, 1, 2}},
+		{"fails late", Slice[Int]{1, 2, 5, 1}, Slice[Int]{1, 2}, Slice[Int]{5, 1}},
+		{"fails last", Slice[Int]{1, 2, 5}, Slice[Int]{1, 2}, Slice[Int]{5}},
+		{"never fails", Slice[Int]{1, 2}, Slice[Int]{1, 2}, Slice[Int]{}},
+		{"empty", Slice[Int]{}, Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		before, after := tt.sl.SplitAtPredicate(small)
+		if !before.Eq(tt.before) || !after.Eq(tt.after) {
+			t.Errorf("%s: SplitAtPredicate() = %v, %v, want %v, %v", tt.name, before, after, tt.before, tt.after)
+		}
+	}
+
+	// Appending to before does not overwrite after or the original slice
+	sl := Slice[Int]{1, 2, 5, 1}
+	before, after := sl.SplitAtPredicate(small)
+	before = append(before, 9)
+	if !after.Eq(Slice[Int]{5, 1}) || !sl.Eq(Slice[Int]{1, 2, 5, 1}) {
+		t.Errorf("appending to before changed after to %v and the slice to %v", after, sl)
+	}
+	if !before.Eq(Slice[Int]{1, 2, 9}) {
+		t.Errorf("before after append = %v, want [1, 2, 9]", before)
+	}
+}
+
+func TestInterleaveFill(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[Int]
+		want      Slice[Int]
+	}{
+		{Slice[Int]{1, 2}, Slice[Int]{3, 4}, Slice[Int]{1, 3, 2, 4}},
+		{Slice[Int]{1, 2, 3}, Slice[Int]{4}, Slice[Int]{1, 4, 2, 0, 3, 0}},
+		{Slice[Int]{1}, Slice[Int]{2, 3, 4}, Slice[Int]{1, 2, 0, 3, 0, 4}},
+		{Slice[Int]{}, Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.InterleaveFill(tt.other, 0); !got.Eq(tt.want) {
+			t.Errorf("%v.InterleaveFill(%v, 0) = %v, want %v", tt.sl, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestTrainTestSplit(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		ratio             F64
+		trainLen, testLen int
+	}{
+		{0.8, 8, 2},
+		{0.25, 2, 8},
+		{0, 0, 10},
+		{1, 10, 0},
+	}
+	for _, tt := range tests {
+		train, test, err := sl.TrainTestSplit(tt.ratio, rand.New(rand.NewSource(42)))
+		if err != nil || train.Len() != tt.trainLen || test.Len() != tt.testLen {
+			t.Errorf("TrainTestSplit(%v) = %v, %v, %v, want lengths %v and %v", tt.ratio, train, test, err, tt.trainLen, tt.testLen)
+			continue
+		}
+		union := train.Concat(test)
+		union.Sort()
+		if !union.Eq(sl) {
+			t.Errorf("TrainTestSplit(%v) = %v, %v, which together are not the original values", tt.ratio, train, test)
+		}
+	}
+
+	// The same seed gives the same split
+	train1, _, _ := sl.TrainTestSplit(0.5, rand.New(rand.NewSource(7)))
+	train2, _, _ := sl.TrainTestSplit(0.5, rand.New(rand.NewSource(7)))
+	if !train1.Eq(train2) {
+		t.Errorf("TrainTestSplit() with the same seed returned %v and %v", train1, train2)
+	}
+
+	for _, ratio := range []F64{-0.1, 1.1, F64(math.NaN())} {
+		if _, _, err := sl.TrainTestSplit(ratio, rand.New(rand.NewSource(1))); err != ErrInvalidArgument {
+			t.Errorf("TrainTestSplit(%v) returned %v, want %v", ratio, err, ErrInvalidArgument)
+		}
+	}
+	if _, _, err := sl.TrainTestSplit(0.5, nil); err != ErrInvalidArgument {
+		t.Errorf("TrainTestSplit() with a nil source returned %v, want %v", err, ErrInvalidArgument)
+	}
+}
+
+func TestModes(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Str]
+		want Slice[Str]
+	}{
+		{Slice[Str]{"the", "cat", "the", "hat"}, Slice[Str]{"the"}},
+		{Slice[Str]{"b", "a", "a", "b", "c"}, Slice[Str]{"b", "a"}},
+		{Slice[Str]{"x", "y"}, Slice[Str]{"x", "y"}},
+		{Slice[Str]{}, Slice[Str]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.Modes(); !got.Eq(tt.want) {
+			t.Errorf("%v.Modes() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	runes := Slice[Rune]{'a', 'b', 'b'}
+	if got := runes.Modes(); !got.Eq(Slice[Rune]{'b'}) {
+		t.Errorf("%v.Modes() = %v, want [b]", runes, got)
+	}
+}
+
+func TestFoldWhile(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4}
+	sumBelow := func(limit Int) func(V, Int) (V, bool) {
+		return func(acc V, v Int) (V, bool) {
+			sum := acc.(Int) + v
+			return sum, sum < limit
+		}
+	}
+	tests := []struct {
+		limit Int
+		want  Int
+	}{
+		{3, 3},
+		{5, 6},
+		{100, 10},
+	}
+	for _, tt := range tests {
+		if got := sl.FoldWhile(Int(0), sumBelow(tt.limit)); got != tt.want {
+			t.Errorf("FoldWhile(0, sumBelow(%v)) = %v, want %v", tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestCountByMany(t *testing.T) {
+	sl := Slice[Int]{-3, 0, 2, -1, 5, 0, 7}
+	isNegative := func(v Int) bool { return v < 0 }
+	isZero := func(v Int) bool { return v == 0 }
+	isPositive := func(v Int) bool { return v > 0 }
+
+	got := sl.CountByMany(isNegative, isZero, isPositive)
+	if !got.Eq(Slice[Int]{2, 2, 3}) {
+		t.Errorf("CountByMany(isNegative, isZero, isPositive) = %v, want [2, 2, 3]", got)
+	}
+	if total := got[0] + got[1] + got[2]; int(total) != sl.Len() {
+		t.Errorf("CountByMany() counts add up to %v, want %v", total, sl.Len())
+	}
+	if got := sl.CountByMany(); !got.IsEmpty() {
+		t.Errorf("CountByMany() without predicates = %v, want []", got)
+	}
+}
+
+func TestRepeatEach(t *testing.T) {
+	sl := Slice[Int]{1, 2}
+	tests := []struct {
+		n    uint
+		want Slice[Int]
+	}{
+		{0, Slice[Int]{}},
+		{1, Slice[Int]{1, 2}},
+		{3, Slice[Int]{1, 1, 1, 2, 2, 2}},
+	}
+	for _, tt := range tests {
+		if got := sl.RepeatEach(tt.n); !got.Eq(tt.want) {
+			t.Errorf("%v.RepeatEach(%v) = %v, want %v", sl, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	tests := []struct {
+		n    int
+		want Int
+		err  error
+	}{
+		{0, 1, nil},
+		{2, 3, nil},
+		{-1, 3, nil},
+		{-3, 1, nil},
+		{-4, 0, ErrOutOfRange},
+		{3, 0, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if got, err := sl.Get(tt.n); got != tt.want || err != tt.err {
+			t.Errorf("%v.Get(%v) = %v, %v, want %v, %v", sl, tt.n, got, err, tt.want, tt.err)
+		}
+	}
+
+	if _, err := (Slice[Int]{}).Get(0); err != ErrIsEmpty {
+		t.Errorf("Get() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		n    int
+		want Slice[Int]
+		err  error
+	}{
+		{0, Slice[Int]{9, 1, 2, 3}, nil},
+		{1, Slice[Int]{1, 9, 2, 3}, nil},
+		{3, Slice[Int]{1, 2, 3, 9}, nil},
+		{-1, Slice[Int]{1, 2, 9, 3}, nil},
+		{-3, Slice[Int]{9, 1, 2, 3}, nil},
+		{-4, Slice[Int]{1, 2, 3}, ErrOutOfRange},
+		{4, Slice[Int]{1, 2, 3}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		sl := Slice[Int]{1, 2, 3}
+		if err := sl.Insert(tt.n, 9); err != tt.err || !sl.Eq(tt.want) {
+			t.Errorf("Insert(%v, 9) -> %v, %v, want %v, %v", tt.n, sl, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestIndexIs(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	tests := []struct {
+		n     int
+		value Int
+		want  bool
+	}{
+		{0, 1, true},
+		{-1, 3, true},
+		{-3, 1, true},
+		{-1, 1, false},
+		{-4, 3, false},
+		{3, 1, false},
+	}
+	for _, tt := range tests {
+		if got := sl.IndexIs(tt.n, tt.value); got != tt.want {
+			t.Errorf("%v.IndexIs(%v, %v) = %v, want %v", sl, tt.n, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMultisetIntersection(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[Int]
+		want      Slice[Int]
+	}{
+		{Slice[Int]{1, 1, 2}, Slice[Int]{1, 2, 2}, Slice[Int]{1, 2}},
+		{Slice[Int]{3, 1, 3, 3, 1}, Slice[Int]{1, 3, 3}, Slice[Int]{3, 1, 3}},
+		{Slice[Int]{1, 2}, Slice[Int]{3, 4}, Slice[Int]{}},
+		{Slice[Int]{}, Slice[Int]{1}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.MultisetIntersection(tt.other); !got.Eq(tt.want) {
+			t.Errorf("%v.MultisetIntersection(%v) = %v, want %v", tt.sl, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestMinBy(t *testing.T) {
+	negate := func(v Int) Int { return -v }
+	abs := func(v Int) Int {
+		if v < 0 {
+			return -v
+		}
+		return v
+	}
+	tests := []struct {
+		name string
+		sl   Slice[Int]
+		f    func(Int) Int
+		want Int
+	}{
+		{"identity", Slice[Int]{3, 1, 2}, func(v Int) Int { return v }, 1},
+		{"negative projections", Slice[Int]{1, 5, 3}, negate, 5},
+		{"absolute value", Slice[Int]{-4, 3, -2}, abs, -2},
+		{"ties keep the first", Slice[Int]{2, -2}, abs, 2},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.MinBy(tt.f); got != tt.want || err != nil {
+			t.Errorf("%s: %v.MinBy() = %v, %v, want %v", tt.name, tt.sl, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[Int]{}).MinBy(negate); err != ErrIsEmpty {
+		t.Errorf("MinBy() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestWindowReduce(t *testing.T) {
+	sl := Slice[Int]{1, 5, 2, 4, 3, 6}
+	max := func(w Slice[Int]) Int {
+		m, _ := w.Max()
+		return m
+	}
+	tests := []struct {
+		size, step uint
+		want       Slice[Int]
+		err        error
+	}{
+		{2, 2, Slice[Int]{5, 4, 6}, nil},
+		{3, 1, Slice[Int]{5, 5, 4, 6}, nil},
+		{2, 3, Slice[Int]{5, 4}, nil},
+		{4, 5, Slice[Int]{5}, nil},
+		{6, 1, Slice[Int]{6}, nil},
+		{0, 1, Slice[Int]{}, ErrInvalidArgument},
+		{1, 0, Slice[Int]{}, ErrInvalidArgument},
+		{7, 1, Slice[Int]{}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if got, err := sl.WindowReduce(tt.size, tt.step, max); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.WindowReduce(%v, %v, max) = %v, %v, want %v, %v", sl, tt.size, tt.step, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestFilterIndices(t *testing.T) {
+	isEven := func(v Int) bool { return v%2 == 0 }
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{1, 4, 3, 6}, Slice[Int]{1, 3}},
+		{Slice[Int]{2, 4}, Slice[Int]{0, 1}},
+		{Slice[Int]{1, 3}, Slice[Int]{}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.FilterIndices(isEven); !got.Eq(tt.want) {
+			t.Errorf("%v.FilterIndices(isEven) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
+
+func TestMaxBy(t *testing.T) {
+	negate := func(v Int) Int { return -v }
+	tests := []struct {
+		name string
+		sl   Slice[Int]
+		f    func(Int) Int
+		want Int
+	}{
+		{"identity", Slice[Int]{1, 3, 2}, func(v Int) Int { return v }, 3},
+		// Every projection is negative, so a zero starting key would win over all of them
+		{"negative projections", Slice[Int]{3, 1, 2}, negate, 1},
+		{"single value", Slice[Int]{4}, negate, 4},
+		{"ties keep the first", Slice[Int]{2, 1, 1}, negate, 1},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.MaxBy(tt.f); got != tt.want || err != nil {
+			t.Errorf("%s: %v.MaxBy() = %v, %v, want %v", tt.name, tt.sl, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[Int]{}).MaxBy(negate); err != ErrIsEmpty {
+		t.Errorf("MaxBy() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestInsertMany(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	tests := []struct {
+		name    string
+		inserts map[int]Slice[Int]
+		want    Slice[Int]
+		err     error
+	}{
+		{"front", map[int]Slice[Int]{0: {8}}, Slice[Int]{8, 1, 2, 3}, nil},
+		{"middle", map[int]Slice[Int]{2: {9, 9}}, Slice[Int]{1, 2, 9, 9, 3}, nil},
+		{"end", map[int]Slice[Int]{3: {7}}, Slice[Int]{1, 2, 3, 7}, nil},
+		{"all at once", map[int]Slice[Int]{0: {8}, 2: {9, 9}, 3: {7}}, Slice[Int]{8, 1, 2, 9, 9, 3, 7}, nil},
+		{"nothing", map[int]Slice[Int]{}, Slice[Int]{1, 2, 3}, nil},
+		{"negative index", map[int]Slice[Int]{-1: {7}}, Slice[Int]{}, ErrOutOfRange},
+		{"past the end", map[int]Slice[Int]{0: {8}, 4: {7}}, Slice[Int]{}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if got, err := sl.InsertMany(tt.inserts); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%s: %v.InsertMany(%v) = %v, %v, want %v, %v", tt.name, sl, tt.inserts, got, err, tt.want, tt.err)
+		}
+	}
+	if !sl.Eq(Slice[Int]{1, 2, 3}) {
+		t.Errorf("InsertMany() modified the slice to %v", sl)
+	}
+}
+
+func TestFirstDifference(t *testing.T) {
+	tests := []struct {
+		sl, other Slice[Int]
+		want      int
+		differ    bool
+	}{
+		{Slice[Int]{1, 2, 3}, Slice[Int]{1, 5, 3}, 1, true},
+		{Slice[Int]{9, 2}, Slice[Int]{1, 2}, 0, true},
+		{Slice[Int]{1, 2}, Slice[Int]{1, 2, 3}, 2, true},
+		{Slice[Int]{1, 2, 3}, Slice[Int]{1, 2}, 2, true},
+		{Slice[Int]{1, 2}, Slice[Int]{1, 2}, -1, false},
+		{Slice[Int]{}, Slice[Int]{}, -1, false},
+		{Slice[Int]{}, Slice[Int]{1}, 0, true},
+	}
+	for _, tt := range tests {
+		if got, differ := tt.sl.FirstDifference(tt.other); got != tt.want || differ != tt.differ {
+			t.Errorf("%v.FirstDifference(%v) = %v, %v, want %v, %v", tt.sl, tt.other, got, differ, tt.want, tt.differ)
+		}
+	}
+}
+
+func TestAllIndexesOf(t *testing.T) {
+	sl := Slice[Int]{1, 2, 1, 3, 1}
+	tests := []struct {
+		v    Int
+		want Slice[Int]
+		err  error
+	}{
+		{1, Slice[Int]{0, 2, 4}, nil},
+		{3, Slice[Int]{3}, nil},
+		{4, Slice[Int]{}, ErrDoesNotExist},
+	}
+	for _, tt := range tests {
+		if got, err := sl.AllIndexesOf(tt.v); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.AllIndexesOf(%v) = %v, %v, want %v, %v", sl, tt.v, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	tests := []struct {
+		n     uint
+		value Int
+		want  Slice[Int]
+		err   error
+	}{
+		{0, 1, Slice[Int]{2, 3}, nil},
+		{1, 2, Slice[Int]{1, 3}, nil},
+		{2, 3, Slice[Int]{1, 2}, nil},
+		{3, 0, Slice[Int]{1, 2, 3}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		sl := Slice[Int]{1, 2, 3}
+		if value, err := sl.Remove(tt.n); value != tt.value || err != tt.err || !sl.Eq(tt.want) {
+			t.Errorf("Remove(%v) -> %v and returned %v, %v, want %v and %v, %v", tt.n, sl, value, err, tt.want, tt.value, tt.err)
+		}
+	}
+
+	empty := Slice[Int]{}
+	if _, err := empty.Remove(0); err != ErrIsEmpty {
+		t.Errorf("Remove() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+
+	// Another slice sharing the backing array is left untouched
+	backing := Slice[Int]{1, 2, 3, 4}
+	sibling := backing[:]
+	if _, err := backing.Remove(1); err != nil || !backing.Eq(Slice[Int]{1, 3, 4}) {
+		t.Errorf("Remove(1) -> %v, %v, want [1, 3, 4]", backing, err)
+	}
+	if !sibling.Eq(Slice[Int]{1, 2, 3, 4}) {
+		t.Errorf("Remove(1) modified a slice sharing the backing array to %v", sibling)
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Str]
+		want F64
+	}{
+		{Slice[Str]{"a", "b", "c", "d"}, 2},
+		{Slice[Str]{"a", "a", "a", "a"}, 0},
+		{Slice[Str]{"a", "b"}, 1},
+		{Slice[Str]{"a", "a", "b", "c"}, 1.5},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.Entropy(); got != tt.want || err != nil {
+			t.Errorf("%v.Entropy() = %v, %v, want %v", tt.sl, got, err, tt.want)
+		}
+	}
+
+	if _, err := (Slice[Str]{}).Entropy(); err != ErrIsEmpty {
+		t.Errorf("Entropy() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestNilReceiver(t *testing.T) {
+	var sl *Slice[Int]
+	if _, err := sl.Pop(); err != ErrIsNil {
+		t.Errorf("Pop() on nil returned %v, want %v", err, ErrIsNil)
+	}
+	if _, err := sl.PopFront(); err != ErrIsNil {
+		t.Errorf("PopFront() on nil returned %v, want %v", err, ErrIsNil)
+	}
+	if _, err := sl.Remove(0); err != ErrIsNil {
+		t.Errorf("Remove() on nil returned %v, want %v", err, ErrIsNil)
+	}
+	if err := sl.Push(1); err != ErrIsNil {
+		t.Errorf("Push() on nil returned %v, want %v", err, ErrIsNil)
+	}
+	if err := sl.PushFront(1); err != ErrIsNil {
+		t.Errorf("PushFront() on nil returned %v, want %v", err, ErrIsNil)
+	}
+	if err := sl.Insert(0, 1); err != ErrIsNil {
+		t.Errorf("Insert() on nil returned %v, want %v", err, ErrIsNil)
+	}
+}
+
+func TestRollingMode(t *testing.T) {
+	sl := Slice[Str]{"a", "a", "b", "b", "b"}
+	tests := []struct {
+		window uint
+		want   Slice[Str]
+		err    error
+	}{
+		{3, Slice[Str]{"a", "b", "b"}, nil},
+		{1, Slice[Str]{"a", "a", "b", "b", "b"}, nil},
+		{2, Slice[Str]{"a", "a", "b", "b"}, nil},
+		{4, Slice[Str]{"a", "b"}, nil},
+		{5, Slice[Str]{"b"}, nil},
+		{0, Slice[Str]{}, ErrInvalidArgument},
+		{6, Slice[Str]{}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if got, err := sl.RollingMode(tt.window); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.RollingMode(%v) = %v, %v, want %v, %v", sl, tt.window, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	double := func(v Int) Int { return v * 2 }
+	sl := make(Slice[Int], 1000)
+	for i := range sl {
+		sl[i] = Int(i)
+	}
+	want := sl.Map(double)
+	for _, workers := range []int{-1, 0, 1, 2, 3, 7, 1000, 2000} {
+		if got := sl.ParallelMap(workers, double); !got.Eq(want) {
+			t.Errorf("ParallelMap(%v, double) is not equal to Map(double)", workers)
+		}
+	}
+
+	// Every value is mapped exactly once
+	var calls atomic.Int64
+	sl.ParallelMap(3, func(v Int) Int {
+		calls.Add(1)
+		return v
+	})
+	if calls.Load() != int64(sl.Len()) {
+		t.Errorf("ParallelMap(3) called f %v times, want %v", calls.Load(), sl.Len())
+	}
+
+	small := Slice[Int]{1, 2, 3, 4, 5}
+	if got := small.ParallelMap(2, double); !got.Eq(Slice[Int]{2, 4, 6, 8, 10}) {
+		t.Errorf("%v.ParallelMap(2, double) = %v, want [2, 4, 6, 8, 10]", small, got)
+	}
+	if got := (Slice[Int]{}).ParallelMap(4, double); !got.IsEmpty() {
+		t.Errorf("[].ParallelMap(4, double) = %v, want []", got)
+	}
+}
+
+func TestMergeAdjacent(t *testing.T) {
+	equal := func(a, b Int) bool { return a == b }
+	add := func(a, b Int) Int { return a + b }
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{1, 1, 3, 2, 2}, Slice[Int]{2, 3, 4}},
+		// The merged value is compared with the next element, so 1+1 merges with the 2 after it
+		{Slice[Int]{1, 1, 2, 4, 8}, Slice[Int]{16}},
+		{Slice[Int]{1, 2, 3}, Slice[Int]{1, 2, 3}},
+		{Slice[Int]{5}, Slice[Int]{5}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.MergeAdjacent(equal, add); !got.Eq(tt.want) {
+			t.Errorf("%v.MergeAdjacent(equal, add) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	// Merge overlapping [start, end] intervals stored as pairs of a flat slice
+	intervals := Slice[Slice[Int]]{{1, 3}, {2, 5}, {7, 8}, {8, 9}}
+	overlap := func(a, b Slice[Int]) bool { return b[0] <= a[1] }
+	join := func(a, b Slice[Int]) Slice[Int] { return Slice[Int]{a[0], max(a[1], b[1])} }
+	want := Slice[Slice[Int]]{{1, 5}, {7, 9}}
+	if got := intervals.MergeAdjacent(overlap, join); !got.Eq(want) {
+		t.Errorf("%v.MergeAdjacent(overlap, join) = %v, want %v", intervals, got, want)
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Str]
+		want Str
+	}{
+		{Slice[Str]{"a", "b", "b", "a", "c"}, "a"},
+		{Slice[Str]{"c", "b", "b"}, "b"},
+		{Slice[Str]{"x"}, "x"},
+		{Slice[Str]{"x", "y", "z"}, "x"},
+	}
+	for _, tt := range tests {
+		if got, err := tt.sl.Mode(); got != tt.want || err != nil {
+			t.Errorf("%v.Mode() = %v, %v, want %v", tt.sl, got, err, tt.want)
+		}
+	}
+
+	nested := Slice[Slice[Int]]{{1}, {2, 3}, {2, 3}}
+	if got, err := nested.Mode(); !got.Eq(Slice[Int]{2, 3}) || err != nil {
+		t.Errorf("%v.Mode() = %v, %v, want [2, 3]", nested, got, err)
+	}
+	if _, err := (Slice[Int]{}).Mode(); err != ErrIsEmpty {
+		t.Errorf("Mode() on empty slice returned %v, want %v", err, ErrIsEmpty)
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	sample := sl.ReservoirSample(4, rand.New(rand.NewSource(1)))
+	if sample.Len() != 4 {
+		t.Errorf("ReservoirSample(4) returned %v values, want 4", sample.Len())
+	}
+	if again := sl.ReservoirSample(4, rand.New(rand.NewSource(1))); !again.Eq(sample) {
+		t.Errorf("ReservoirSample() with the same seed returned %v and %v", sample, again)
+	}
+	seen := Slice[Int]{}
+	for _, v := range sample {
+		if !sl.Contains(v) || seen.Contains(v) {
+			t.Errorf("ReservoirSample(4) = %v, want 4 distinct values of the slice", sample)
+			break
+		}
+		seen.Push(v)
+	}
+
+	if got := sl.ReservoirSample(20, nil); !got.Eq(sl) {
+		t.Errorf("ReservoirSample(20) = %v, want the whole slice", got)
+	}
+	if got := sl.ReservoirSample(0, nil); !got.IsEmpty() {
+		t.Errorf("ReservoirSample(0) = %v, want []", got)
+	}
+
+	// Every value is picked about k/n of the time
+	r := rand.New(rand.NewSource(42))
+	counts := make(map[Int]int)
+	const trials = 20000
+	for i := 0; i < trials; i++ {
+		for _, v := range sl.ReservoirSample(3, r) {
+			counts[v]++
+		}
+	}
+	for _, v := range sl {
+		if p := float64(counts[v]) / trials; math.Abs(p-0.3) > 0.02 {
+			t.Errorf("value %v was picked %.3f of the time, want about 0.3", v, p)
+		}
+	}
+}
+
+func TestMonotonicStack(t *testing.T) {
+	tests := []struct {
+		sl          Slice[Int]
+		keepGreater bool
+		want        Slice[Int]
+	}{
+		{Slice[Int]{3, 1, 2, 4}, true, Slice[Int]{-1, 0, 0, -1}},
+		{Slice[Int]{3, 1, 2, 4}, false, Slice[Int]{-1, -1, 1, 2}},
+		{Slice[Int]{5, 4, 3}, true, Slice[Int]{-1, 0, 1}},
+		{Slice[Int]{1, 2, 3}, true, Slice[Int]{-1, -1, -1}},
+		// Equal values are neither greater nor smaller
+		{Slice[Int]{2, 2}, true, Slice[Int]{-1, -1}},
+		{Slice[Int]{2, 2}, false, Slice[Int]{-1, -1}},
+		{Slice[Int]{}, true, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.MonotonicStack(tt.keepGreater); !got.Eq(tt.want) {
+			t.Errorf("%v.MonotonicStack(%v) = %v, want %v", tt.sl, tt.keepGreater, got, tt.want)
+		}
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	tests := []struct {
+		sl        Slice[Int]
+		even, odd Slice[Int]
+	}{
+		{Slice[Int]{1, 4, 2, 5, 3}, Slice[Int]{1, 2, 3}, Slice[Int]{4, 5}},
+		{Slice[Int]{1, 2}, Slice[Int]{1}, Slice[Int]{2}},
+		{Slice[Int]{1}, Slice[Int]{1}, Slice[Int]{}},
+		{Slice[Int]{}, Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if even, odd := tt.sl.Unzip(); !even.Eq(tt.even) || !odd.Eq(tt.odd) {
+			t.Errorf("%v.Unzip() = %v, %v, want %v, %v", tt.sl, even, odd, tt.even, tt.odd)
+		}
+	}
+
+	// Unzip is the inverse of Zip for slices of equal length
+	a, b := Slice[Str]{"a", "b", "c"}, Slice[Str]{"x", "y", "z"}
+	if gotA, gotB := a.Zip(b).Unzip(); !gotA.Eq(a) || !gotB.Eq(b) {
+		t.Errorf("%v.Zip(%v).Unzip() = %v, %v", a, b, gotA, gotB)
+	}
+	zipped := Slice[Int]{1, 4, 2, 5, 3, 6}
+	if even, odd := zipped.Unzip(); !even.Zip(odd).Eq(zipped) {
+		t.Errorf("Zip() of %v.Unzip() is not the original slice", zipped)
+	}
+}
+
+func TestScan(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	add := func(acc, v Int) Int { return acc + v }
+	sub := func(acc, v Int) Int { return acc - v }
+	if got := sl.Scan(0, add); !got.Eq(Slice[Int]{1, 3, 6}) {
+		t.Errorf("%v.Scan(0, add) = %v, want [1, 3, 6]", sl, got)
+	}
+	if got := sl.Scan(10, sub); !got.Eq(Slice[Int]{9, 7, 4}) {
+		t.Errorf("%v.Scan(10, sub) = %v, want [9, 7, 4]", sl, got)
+	}
+
+	// The last value is the same as the result of Fold
+	if got, want := sl.Scan(0, add)[sl.Len()-1], sl.Fold(Int(0), func(acc V, v Int) V { return acc.(Int) + v }); got != want {
+		t.Errorf("last value of Scan() = %v, want %v", got, want)
+	}
+	if got := (Slice[Int]{}).Scan(5, add); !got.IsEmpty() {
+		t.Errorf("[].Scan(5, add) = %v, want []", got)
+	}
+}
+
+func TestAllEqual(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want bool
+	}{
+		{Slice[Int]{1, 1, 1}, true},
+		{Slice[Int]{1, 2, 1}, false},
+		{Slice[Int]{1, 1, 2}, false},
+		{Slice[Int]{4}, true},
+		{Slice[Int]{}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.AllEqual(); got != tt.want {
+			t.Errorf("%v.AllEqual() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	nested := Slice[Slice[Int]]{{1, 2}, {1, 2}}
+	if !nested.AllEqual() {
+		t.Errorf("%v.AllEqual() = false, want true", nested)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3, 4}
+	var seen Slice[Int]
+	isOdd := func(v Int) bool { return v%2 == 1 }
+	double := func(v Int) Int { return v * 2 }
+
+	got := sl.Filter(isOdd).Inspect(func(v Int) { seen.Push(v) }).Map(double)
+	if !seen.Eq(Slice[Int]{1, 3}) {
+		t.Errorf("Inspect() saw %v, want [1, 3]", seen)
+	}
+	if !got.Eq(Slice[Int]{2, 6}) {
+		t.Errorf("Filter(isOdd).Inspect().Map(double) = %v, want [2, 6]", got)
+	}
+	if !sl.Eq(Slice[Int]{1, 2, 3, 4}) {
+		t.Errorf("Inspect() modified the slice to %v", sl)
+	}
+
+	calls := 0
+	if got := (Slice[Int]{}).Inspect(func(Int) { calls++ }); !got.IsEmpty() || calls != 0 {
+		t.Errorf("[].Inspect() = %v and called f %v times, want [] and 0", got, calls)
+	}
+}
+
+func TestMapFixpoint(t *testing.T) {
+	half := func(v Int) Int { return v / 2 }
+	tests := []struct {
+		sl      Slice[Int]
+		maxIter int
+		want    Slice[Int]
+		iter    int
+		err     error
+	}{
+		{Slice[Int]{100, 7}, 10, Slice[Int]{0, 0}, 8, nil},
+		{Slice[Int]{0}, 10, Slice[Int]{0}, 1, nil},
+		{Slice[Int]{100, 7}, 3, Slice[Int]{12, 0}, 3, ErrNoConvergence},
+		{Slice[Int]{1}, 0, Slice[Int]{1}, 0, ErrNoConvergence},
+		{Slice[Int]{}, 5, Slice[Int]{}, 1, nil},
+	}
+	for _, tt := range tests {
+		got, iter, err := tt.sl.MapFixpoint(half, tt.maxIter)
+		if !got.Eq(tt.want) || iter != tt.iter || err != tt.err {
+			t.Errorf("%v.MapFixpoint(half, %v) = %v, %v, %v, want %v, %v, %v", tt.sl, tt.maxIter, got, iter, err, tt.want, tt.iter, tt.err)
+		}
+	}
+
+	// A function that never settles does not converge
+	negate := func(v Int) Int { return -v }
+	if _, iter, err := (Slice[Int]{1}).MapFixpoint(negate, 5); iter != 5 || err != ErrNoConvergence {
+		t.Errorf("[1].MapFixpoint(negate, 5) returned %v, %v, want 5, %v", iter, err, ErrNoConvergence)
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{1, 2, 3}, Slice[Int]{1, 0, 2, 0, 3}},
+		{Slice[Int]{1, 2}, Slice[Int]{1, 0, 2}},
+		{Slice[Int]{1}, Slice[Int]{1}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.Intersperse(0); !got.Eq(tt.want) {
+			t.Errorf("%v.Intersperse(0) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	words := Slice[Str]{"a", "b", "c"}
+	if got := words.Intersperse(","); !got.Eq(Slice[Str]{"a", ",", "b", ",", "c"}) {
+		t.Errorf("%v.Intersperse(,) = %v, want [a, ,, b, ,, c]", words, got)
+	}
+	if !words.Eq(Slice[Str]{"a", "b", "c"}) {
+		t.Errorf("Intersperse() modified the slice to %v", words)
+	}
+}
+
+func TestPrefixFunction(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{1, 2, 1, 2, 1}, Slice[Int]{0, 0, 1, 2, 3}},
+		{Slice[Int]{1, 1, 2, 1, 1, 1}, Slice[Int]{0, 1, 0, 1, 2, 2}},
+		{Slice[Int]{1, 2, 3}, Slice[Int]{0, 0, 0}},
+		{Slice[Int]{5, 5, 5}, Slice[Int]{0, 1, 2}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.PrefixFunction(); !got.Eq(tt.want) {
+			t.Errorf("%v.PrefixFunction() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+
+	// The classic KMP example
+	word := Slice[Rune]([]Rune("abacaba"))
+	if got := word.PrefixFunction(); !got.Eq(Slice[Int]{0, 0, 1, 0, 1, 2, 3}) {
+		t.Errorf("abacaba.PrefixFunction() = %v, want [0, 0, 1, 0, 1, 2, 3]", got)
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	toStr := func(v Int) Str { return Str(strconv.Itoa(int(v))) }
+	if got := MapTo(sl, toStr); !got.Eq(Slice[Str]{"1", "2", "3"}) {
+		t.Errorf("MapTo(%v, toStr) = %v, want [1, 2, 3]", sl, got)
+	}
+
+	words := Slice[Str]{"go", "", "slice"}
+	length := func(s Str) Int { return Int(len(s)) }
+	if got := MapTo(words, length); !got.Eq(Slice[Int]{2, 0, 5}) {
+		t.Errorf("MapTo(%v, length) = %v, want [2, 0, 5]", words, got)
+	}
+
+	if got := MapTo(Slice[Int]{}, toStr); !got.IsEmpty() {
+		t.Errorf("MapTo([], toStr) = %v, want []", got)
+	}
+}
+
+func TestFoldInto(t *testing.T) {
+	sl := Slice[Int]{1, 2, 3}
+	if got := FoldInto(sl, 0, func(acc int, v Int) int { return acc + int(v) }); got != 6 {
+		t.Errorf("FoldInto(%v, 0, sum) = %v, want 6", sl, got)
+	}
+	if got := FoldInto(Slice[Int]{}, 7, func(acc int, v Int) int { return acc + int(v) }); got != 7 {
+		t.Errorf("FoldInto([], 7, sum) = %v, want 7", got)
+	}
+
+	words := Slice[Str]{"a", "b", "a", "c", "a"}
+	histogram := FoldInto(words, map[Str]int{}, func(acc map[Str]int, v Str) map[Str]int {
+		acc[v]++
+		return acc
+	})
+	if len(histogram) != 3 || histogram["a"] != 3 || histogram["b"] != 1 || histogram["c"] != 1 {
+		t.Errorf("FoldInto(%v, {}, histogram) = %v, want map[a:3 b:1 c:1]", words, histogram)
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	tests := []struct {
+		sl       Slice[Int]
+		want     int
+		repeated bool
+	}{
+		{Slice[Int]{1, 2, 1, 2, 1, 2}, 2, true},
+		{Slice[Int]{1, 2, 1, 2, 1}, 5, false},
+		{Slice[Int]{7, 7, 7}, 1, true},
+		{Slice[Int]{1, 2, 3, 1, 2, 3}, 3, true},
+		{Slice[Int]{1, 2, 3}, 3, false},
+		{Slice[Int]{4}, 1, false},
+		{Slice[Int]{}, 0, false},
+	}
+	for _, tt := range tests {
+		if got, repeated := tt.sl.Period(); got != tt.want || repeated != tt.repeated {
+			t.Errorf("%v.Period() = %v, %v, want %v, %v", tt.sl, got, repeated, tt.want, tt.repeated)
+		}
+	}
+}
+
+func TestEvery(t *testing.T) {
+	positive := func(v Int) bool { return v > 0 }
+	tests := []struct {
+		sl   Slice[Int]
+		want bool
+	}{
+		{Slice[Int]{1, 2, 3}, true},
+		{Slice[Int]{1, -2, 3}, false},
+		{Slice[Int]{}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.Every(positive); got != tt.want {
+			t.Errorf("%v.Every(positive) = %v, want %v", tt.sl, got, tt.want)
+		}
+		// The deprecated All gives the same answer
+		if got := tt.sl.All(positive); got != tt.want {
+			t.Errorf("%v.All(positive) = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
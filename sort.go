@@ -110,3 +110,185 @@ func (sl Slice[T]) IsSortedBy(f func(v1, v2 T) bool) bool {
 	}
 	return true
 }
+
+// The largest amount of counters CountingSort allocates before falling back to Sort.
+const maxCountingSortRange = 1 << 20
+
+// # CountingSort
+//
+// Sorts a slice of integers in place using counting sort, in O(n + k) time where k is the
+// difference between the largest and the smallest value.
+//
+//	[3,1,2,1]CountingSort() return [1,1,2,3]
+//
+// Returns ErrNotInteger if T is not one of the integer types.
+// If k is too large for counting to pay off the slice is sorted with Sort instead.
+func (sl Slice[T]) CountingSort() error {
+	if _, ok := toInt64(sl.Default()); !ok {
+		return ErrNotInteger
+	}
+	if sl.Len() <= 1 {
+		return nil
+	}
+
+	keys := make([]int64, sl.Len())
+	for i, v := range sl {
+		key, ok := toInt64(v)
+		if !ok {
+			sl.Sort()
+			return nil
+		}
+		keys[i] = key
+	}
+
+	min, max := keys[0], keys[0]
+	for _, key := range keys {
+		if key < min {
+			min = key
+		}
+		if key > max {
+			max = key
+		}
+	}
+	if uint64(max-min) >= maxCountingSortRange {
+		sl.Sort()
+		return nil
+	}
+
+	counts := make([]int, max-min+2)
+	for _, key := range keys {
+		counts[key-min+1]++
+	}
+	for i := 1; i < len(counts); i++ {
+		counts[i] += counts[i-1]
+	}
+
+	sorted := make(Slice[T], sl.Len())
+	for i, key := range keys {
+		sorted[counts[key-min]] = sl[i]
+		counts[key-min]++
+	}
+	copy(sl, sorted)
+	return nil
+}
+
+// # IsMonotonic
+//
+// Returns true if the slice is entirely non-decreasing or entirely non-increasing.
+//
+//	[1,2,2,3]IsMonotonic() return true
+//	[3,2,2,1]IsMonotonic() return true
+//	[1,3,2]IsMonotonic() return false
+func (sl Slice[T]) IsMonotonic() bool {
+	increasing, decreasing := true, true
+	for i := 1; i < sl.Len(); i++ {
+		if sl[i].Lt(sl[i-1]) {
+			increasing = false
+		}
+		if sl[i].Gt(sl[i-1]) {
+			decreasing = false
+		}
+	}
+	return increasing || decreasing
+}
+
+// # IsStrictlyMonotonic
+//
+// Returns true if the slice is entirely increasing or entirely decreasing.
+//
+//	[1,2,3]IsStrictlyMonotonic() return true
+//	[1,2,2,3]IsStrictlyMonotonic() return false
+func (sl Slice[T]) IsStrictlyMonotonic() bool {
+	increasing, decreasing := true, true
+	for i := 1; i < sl.Len(); i++ {
+		if !sl[i].Gt(sl[i-1]) {
+			increasing = false
+		}
+		if !sl[i].Lt(sl[i-1]) {
+			decreasing = false
+		}
+	}
+	return increasing || decreasing
+}
+
+// # SortByFrequency
+//
+// Return a new slice with the values sorted by how often they occur, most frequent first.
+// Values that occur equally often are kept in order of first occurance.
+//
+//	[3,1,2,1,2,1]SortByFrequency() return [1,1,1,2,2,3]
+func (sl Slice[T]) SortByFrequency() Slice[T] {
+	unique, counts := sl.UniqueWithCounts()
+	order := make(Slice[Int], unique.Len())
+	for i := range order {
+		order[i] = Int(i)
+	}
+	order.SortBy(func(a, b Int) bool {
+		return counts[a] >= counts[b]
+	})
+
+	sorted := make(Slice[T], 0, sl.Len())
+	for _, i := range order {
+		for n := Int(0); n < counts[i]; n++ {
+			sorted.Push(unique[i])
+		}
+	}
+	return sorted
+}
+
+// # Rank
+//
+// Return the 1-based rank of every value in the sorted order of the slice. The slice itself is not modified.
+// Method decides the rank of values that are equal:
+//
+//	"average" gives the average of the ranks they would take up
+//	"min" gives the lowest of the ranks they would take up
+//	"max" gives the highest of the ranks they would take up
+//	"dense" works like "min", but the next distinct value gets the next rank instead of skipping the ranks taken up
+//
+//	[10,20,10,30]Rank("average") return [1.5,3,1.5,4]
+//	[10,20,10,30]Rank("min") return [1,3,1,4]
+//	[10,20,10,30]Rank("max") return [2,3,2,4]
+//	[10,20,10,30]Rank("dense") return [1,2,1,3]
+//
+// Returns ErrInvalidArgument if method is not one of the above.
+func (sl Slice[T]) Rank(method string) (Slice[F64], error) {
+	switch method {
+	case "average", "min", "max", "dense":
+	default:
+		return New[F64](), ErrInvalidArgument
+	}
+	order := make(Slice[Int], sl.Len())
+	for i := range order {
+		order[i] = Int(i)
+	}
+	order.SortBy(func(a, b Int) bool {
+		return !sl[b].Lt(sl[a])
+	})
+
+	ranks := make(Slice[F64], sl.Len())
+	dense := 0
+	for start := 0; start < order.Len(); {
+		end := start + 1
+		for end < order.Len() && sl[order[end]].Eq(sl[order[start]]) {
+			end++
+		}
+		dense++
+		var rank F64
+		switch method {
+		case "average":
+			rank = F64(start+1+end) / 2
+		case "min":
+			rank = F64(start + 1)
+		case "max":
+			rank = F64(end)
+		case "dense":
+			rank = F64(dense)
+		}
+		for _, i := range order[start:end] {
+			ranks[i] = rank
+		}
+		start = end
+	}
+	return ranks, nil
+}
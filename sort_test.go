@@ -0,0 +1,133 @@
+package sliceutils
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCountingSort(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{3, 1, 2, 1}, Slice[Int]{1, 1, 2, 3}},
+		{Slice[Int]{-2, 5, 0, -2}, Slice[Int]{-2, -2, 0, 5}},
+		{Slice[Int]{1 << 40, 1, 0}, Slice[Int]{0, 1, 1 << 40}},
+		{Slice[Int]{7}, Slice[Int]{7}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		sl := append(Slice[Int]{}, tt.sl...)
+		if err := sl.CountingSort(); err != nil || !sl.Eq(tt.want) {
+			t.Errorf("%v.CountingSort() -> %v, %v, want %v", tt.sl, sl, err, tt.want)
+		}
+	}
+
+	u8 := Slice[U8]{200, 3, 255, 0, 3}
+	if err := u8.CountingSort(); err != nil || !u8.Eq(Slice[U8]{0, 3, 3, 200, 255}) {
+		t.Errorf("CountingSort() on U8 -> %v, %v", u8, err)
+	}
+
+	if err := (Slice[Str]{"b", "a"}).CountingSort(); err != ErrNotInteger {
+		t.Errorf("CountingSort() on Str returned %v, want %v", err, ErrNotInteger)
+	}
+}
+
+func randomU8s(n int) Slice[U8] {
+	r := rand.New(rand.NewSource(1))
+	sl := make(Slice[U8], n)
+	for i := range sl {
+		sl[i] = U8(r.Intn(256))
+	}
+	return sl
+}
+
+func BenchmarkCountingSort(b *testing.B) {
+	data := randomU8s(1 << 16)
+	sl := make(Slice[U8], data.Len())
+	for i := 0; i < b.N; i++ {
+		copy(sl, data)
+		sl.CountingSort()
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	data := randomU8s(1 << 16)
+	sl := make(Slice[U8], data.Len())
+	for i := 0; i < b.N; i++ {
+		copy(sl, data)
+		sl.Sort()
+	}
+}
+
+func TestIsMonotonic(t *testing.T) {
+	tests := []struct {
+		name              string
+		sl                Slice[Int]
+		monotonic, strict bool
+	}{
+		{"ascending", Slice[Int]{1, 2, 3}, true, true},
+		{"descending", Slice[Int]{3, 2, 1}, true, true},
+		{"non-decreasing", Slice[Int]{1, 2, 2, 3}, true, false},
+		{"constant", Slice[Int]{2, 2, 2}, true, false},
+		{"mixed", Slice[Int]{1, 3, 2}, false, false},
+		{"single", Slice[Int]{1}, true, true},
+		{"empty", Slice[Int]{}, true, true},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.IsMonotonic(); got != tt.monotonic {
+			t.Errorf("%s: IsMonotonic() = %v, want %v", tt.name, got, tt.monotonic)
+		}
+		if got := tt.sl.IsStrictlyMonotonic(); got != tt.strict {
+			t.Errorf("%s: IsStrictlyMonotonic() = %v, want %v", tt.name, got, tt.strict)
+		}
+	}
+}
+
+func TestSortByFrequency(t *testing.T) {
+	tests := []struct {
+		sl   Slice[Int]
+		want Slice[Int]
+	}{
+		{Slice[Int]{3, 1, 2, 1, 2, 1}, Slice[Int]{1, 1, 1, 2, 2, 3}},
+		{Slice[Int]{1, 2, 3, 3, 2}, Slice[Int]{2, 2, 3, 3, 1}},
+		{Slice[Int]{9, 8, 7}, Slice[Int]{9, 8, 7}},
+		{Slice[Int]{}, Slice[Int]{}},
+	}
+	for _, tt := range tests {
+		if got := tt.sl.SortByFrequency(); !got.Eq(tt.want) {
+			t.Errorf("%v.SortByFrequency() = %v, want %v", tt.sl, got, tt.want)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	sl := Slice[Int]{10, 20, 10, 30}
+	tests := []struct {
+		method string
+		want   Slice[F64]
+		err    error
+	}{
+		{"average", Slice[F64]{1.5, 3, 1.5, 4}, nil},
+		{"min", Slice[F64]{1, 3, 1, 4}, nil},
+		{"max", Slice[F64]{2, 3, 2, 4}, nil},
+		{"dense", Slice[F64]{1, 2, 1, 3}, nil},
+		{"first", Slice[F64]{}, ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		if got, err := sl.Rank(tt.method); err != tt.err || !got.Eq(tt.want) {
+			t.Errorf("%v.Rank(%q) = %v, %v, want %v, %v", sl, tt.method, got, err, tt.want, tt.err)
+		}
+	}
+
+	ties := Slice[Str]{"b", "a", "b", "b"}
+	if got, err := ties.Rank("average"); err != nil || !got.Eq(Slice[F64]{3, 1, 3, 3}) {
+		t.Errorf("%v.Rank(average) = %v, %v, want [3, 1, 3, 3]", ties, got, err)
+	}
+	if !sl.Eq(Slice[Int]{10, 20, 10, 30}) {
+		t.Errorf("Rank() modified the slice to %v", sl)
+	}
+	if got, err := (Slice[Int]{}).Rank("min"); err != nil || !got.IsEmpty() {
+		t.Errorf("[].Rank(min) = %v, %v, want []", got, err)
+	}
+}
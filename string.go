@@ -0,0 +1,104 @@
+package sliceutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// string
+//
+// Implementations of fmt.Stringer, so that slices and values print in a readable form with %v and %s.
+
+// # String
+//
+// Implementation of fmt.Stringer on Slice[T]. Nested slices are printed recursively.
+//
+//	[1,2,3]String() return "[1, 2, 3]"
+//	[[1,2],[3]]String() return "[[1, 2], [3]]"
+func (sl Slice[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, v := range sl {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprint(&sb, v)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// String implementations for all builtin types
+
+func (b Bool) String() string {
+	return strconv.FormatBool(bool(b))
+}
+
+func (s Str) String() string {
+	return string(s)
+}
+
+func (r Rune) String() string {
+	return string(r)
+}
+
+func (i Int) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i I8) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i I16) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i I32) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i I64) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (u Uint) String() string {
+	return strconv.FormatUint(uint64(u), 10)
+}
+
+func (u U8) String() string {
+	return strconv.FormatUint(uint64(u), 10)
+}
+
+func (u U16) String() string {
+	return strconv.FormatUint(uint64(u), 10)
+}
+
+func (u U32) String() string {
+	return strconv.FormatUint(uint64(u), 10)
+}
+
+func (u U64) String() string {
+	return strconv.FormatUint(uint64(u), 10)
+}
+
+func (b Byte) String() string {
+	return strconv.FormatUint(uint64(b), 10)
+}
+
+func (f F32) String() string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
+
+func (f F64) String() string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 64)
+}
+
+func (c C64) String() string {
+	return strconv.FormatComplex(complex128(c), 'g', -1, 64)
+}
+
+func (c C128) String() string {
+	return strconv.FormatComplex(complex128(c), 'g', -1, 128)
+}
@@ -0,0 +1,34 @@
+package sliceutils
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{Slice[Int]{1, 2, 3}, "[1, 2, 3]"},
+		{Slice[Int]{}, "[]"},
+		{Slice[Str]{"a", "b"}, "[a, b]"},
+		{Slice[Rune]{'x'}, "[x]"},
+		{Slice[Bool]{true, false}, "[true, false]"},
+		{Slice[F64]{1.5, 2}, "[1.5, 2]"},
+		{Slice[U8]{255}, "[255]"},
+		{Slice[C128]{complex(1, -2)}, "[(1-2i)]"},
+		{Slice[Slice[Int]]{{1, 2}, {3}}, "[[1, 2], [3]]"},
+		{Slice[U]{Int(1), Str("a"), Slice[Int]{2}}, "[1, a, [2]]"},
+		{I8(-8), "-8"},
+		{F32(0.1), "0.1"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf("%v", tt.v); got != tt.want {
+			t.Errorf("fmt.Sprintf(%%v) = %q, want %q", got, tt.want)
+		}
+		if got := fmt.Sprint(tt.v); got != tt.want {
+			t.Errorf("fmt.Sprint() = %q, want %q", got, tt.want)
+		}
+	}
+}